@@ -170,6 +170,7 @@ const (
 	kafkaClusterTypeFreight                              = "Freight"
 	kafkaClusterTypeStandard                             = "Standard"
 	kafkaMirrorTopicLoggingKey                           = "kafka_mirror_topic_id"
+	kafkaRecordsLoggingKey                               = "kafka_records_id"
 	kafkaQuotasAPIWaitAfterCreate                        = 30 * time.Second
 	kafkaQuotasAPIWaitAfterUpdate                        = 15 * time.Second
 	kafkaRestAPIWaitAfterCreate                          = 10 * time.Second
@@ -197,6 +198,7 @@ const (
 	listIdentityPoolsPageSize                            = 99
 	listIdentityProvidersPageSize                        = 99
 	listIPAddressesPageSize                              = 99
+	listKafkaClientQuotasPageSize                        = 99
 	listKafkaClustersPageSize                            = 99
 	listKsqlClustersPageSize                             = 99
 	listNetworkLinkServicesPageSize                      = 99
@@ -233,6 +235,8 @@ const (
 	paramAccessPoint                                     = "access_point"
 	paramAccessPointID                                   = "access_point_id"
 	paramAccount                                         = "account"
+	paramAclFilter                                       = "acl_filter"
+	paramAction                                          = "action"
 	paramAddressType                                     = "address_type"
 	paramAddressTypes                                    = "address_types"
 	paramAlgorithm                                       = "algorithm"
@@ -240,6 +244,7 @@ const (
 	paramAllowDeletion                                   = "allow_deletion"
 	paramAllowedScope                                    = "allowed_scope"
 	paramApiKey                                          = "api_key"
+	paramApiSecret                                       = "api_secret"
 	paramApiVersion                                      = "api_version"
 	paramArtifactFile                                    = "artifact_file"
 	paramAttributeDef                                    = "attribute_definition"
@@ -288,11 +293,14 @@ const (
 	paramCertificateAuthority                            = "certificate_authority"
 	paramCertificateChain                                = "certificate_chain"
 	paramCertificateChainFilename                        = "certificate_chain_filename"
+	paramCheckActiveConsumerGroups                       = "check_active_consumer_groups"
+	paramCheckActiveConsumerGroupsDefaultValue           = false
 	paramCidr                                            = "cidr"
 	paramCidrBlocks                                      = "cidr_blocks"
 	paramCku                                             = "cku"
 	paramClass                                           = "class"
 	paramClientId                                        = "client_id"
+	paramClientQuotas                                    = "client_quotas"
 	paramClientSecret                                    = "client_secret"
 	paramCloud                                           = "cloud"
 	paramClouds                                          = "clouds"
@@ -321,6 +329,7 @@ const (
 	paramConnectorClass                                  = "connector_class"
 	paramConnectorClassName                              = "connector_class_name"
 	paramConnectorType                                   = "connector_type"
+	paramConsumerGroupFilter                             = "consumer_group_filter"
 	paramConstraints                                     = "constraints"
 	paramConstraintsColumns                              = "columns"
 	paramConstraintsEnforced                             = "enforced"
@@ -329,7 +338,9 @@ const (
 	paramContainerName                                   = "container_name"
 	paramContentFormat                                   = "content_format"
 	paramContext                                         = "context"
+	paramContexts                                        = "contexts"
 	paramContextType                                     = "context_type"
+	paramCreatedAt                                       = "created_at"
 	paramCreator                                         = "creator"
 	paramCredentialIdentity                              = "credential_identity"
 	paramCredentials                                     = "credentials"
@@ -349,7 +360,10 @@ const (
 	paramDefaultPool                                     = "default_pool"
 	paramDefaultPoolEnabled                              = "default_compute_pool_enabled"
 	paramDefaultValue                                    = "default_value"
+	paramDeks                                            = "deks"
+	paramDeletedVersions                                 = "deleted_versions"
 	paramDeletionProtection                              = "deletion_protection"
+	paramDeletionProtectionDefaultValue                  = false
 	paramDescription                                     = "description"
 	paramDestinationKafkaCluster                         = "destination_kafka_cluster"
 	paramDestinationKafkaCredentials                     = "destination_kafka_cluster.0.credentials"
@@ -370,6 +384,8 @@ const (
 	paramDomainMappings                                  = "domain_mappings"
 	paramDomainRules                                     = "domain_rules"
 	paramDomains                                         = "domains"
+	paramEarliestOffset                                  = "earliest_offset"
+	paramEffectiveConfig                                 = "effective_config"
 	paramEgressByteRate                                  = "egress_byte_rate"
 	paramEmail                                           = "email"
 	paramEncodingRules                                   = "encoding_rules"
@@ -389,15 +405,21 @@ const (
 	paramEntityTypes                                     = "entity_types"
 	paramEnvironment                                     = "environment"
 	paramEnvironments                                    = "environments"
+	paramErrorCode                                       = "error_code"
 	paramErrorHandling                                   = "error_handling"
+	paramErrorMessage                                    = "error_message"
+	paramErrors                                          = "errors"
 	paramExpirationDates                                 = "expiration_dates"
 	paramExpiresAt                                       = "expires_at"
 	paramExpr                                            = "expr"
 	paramExternalId                                      = "external_id"
 	paramExternalIdentifier                              = "external_identifier"
+	paramFile                                            = "file"
+	paramFileHashes                                      = "file_hashes"
 	paramFilename                                        = "filename"
 	paramFilter                                          = "filter"
 	paramFilterName                                      = "filter_name"
+	paramFilterType                                      = "filter_type"
 	paramFingerprints                                    = "fingerprints"
 	paramForce                                           = "force"
 	paramForceDefaultValue                               = false
@@ -424,6 +446,7 @@ const (
 	paramGroupName                                       = "group_name"
 	paramHardDelete                                      = "hard_delete"
 	paramHardDeleteDefaultValue                          = false
+	paramHeader                                          = "header"
 	paramHost                                            = "host"
 	paramHttpEndpoint                                    = "http_endpoint"
 	paramIAMPrincipal                                    = "iam_principal"
@@ -434,11 +457,15 @@ const (
 	paramIds                                             = "ids"
 	paramImportCustomRoutes                              = "import_custom_routes"
 	paramIngressByteRate                                 = "ingress_byte_rate"
+	paramInSyncReplicas                                  = "in_sync_replicas"
 	paramIpAddresses                                     = "ip_addresses"
 	paramIPGroups                                        = "ip_groups"
 	paramIpPrefix                                        = "ip_prefix"
+	paramIsDefault                                       = "is_default"
 	paramIsOptional                                      = "is_optional"
 	paramIsPrivate                                       = "is_private"
+	paramIsReadOnly                                      = "is_read_only"
+	paramIsSensitive                                     = "is_sensitive"
 	paramIssuer                                          = "issuer"
 	paramJwksUri                                         = "jwks_uri"
 	paramKafkaCluster                                    = "kafka_cluster"
@@ -449,8 +476,16 @@ const (
 	paramKind                                            = "kind"
 	paramKmsKeyId                                        = "kms_key_id"
 	paramKmsType                                         = "kms_type"
+	paramLag                                             = "lag"
+	paramLastSourceFetchOffset                           = "last_source_fetch_offset"
+	paramLatestOffset                                    = "latest_offset"
 	paramLatestOffsets                                   = "latest_offsets"
 	paramLatestOffsetsTimestamp                          = "latest_offsets_timestamp"
+	paramLatestSchemaIdentifier                          = "latest_schema_identifier"
+	paramLatestVersion                                   = "latest_version"
+	paramLeader                                          = "leader"
+	paramLinkError                                       = "link_error"
+	paramLinkErrorMessage                                = "link_error_message"
 	paramLinkMode                                        = "link_mode"
 	paramLinkName                                        = "link_name"
 	paramLinkState                                       = "link_state"
@@ -460,7 +495,9 @@ const (
 	paramManagedStorage                                  = "managed_storage"
 	paramMaxCFU                                          = "default_max_cfu"
 	paramMaxCfu                                          = "max_cfu"
+	paramMaxCkuStep                                      = "max_cku_step"
 	paramMaxEcku                                         = "max_ecku"
+	paramMaxLag                                          = "max_lag"
 	paramMetadata                                        = "metadata"
 	paramMetadataColumnNamingScheme                      = "metadata_column_naming_scheme"
 	paramMetadataComment                                 = "column_metadata_comment"
@@ -469,8 +506,16 @@ const (
 	paramMetadataName                                    = "column_metadata_name"
 	paramMetadataType                                    = "column_metadata_type"
 	paramMetadataVirtual                                 = "column_metadata_virtual"
+	paramMigratedSchemas                                 = "migrated_schemas"
 	paramMigrationRules                                  = "migration_rules"
+	paramMirrorLags                                      = "mirror_lags"
+	paramMirrorStatus                                    = "mirror_status"
+	paramMirrorTopicFilter                               = "mirror_topic_filter"
 	paramMirrorTopicName                                 = "mirror_topic_name"
+	paramMirrorTopicNamePattern                          = "mirror_topic_name_pattern"
+	paramMirrorTopicNamePrefix                           = "mirror_topic_name_prefix"
+	paramMirrorTopicNames                                = "mirror_topic_names"
+	paramMirrorTopics                                    = "mirror_topics"
 	paramMode                                            = "mode"
 	paramName                                            = "name"
 	paramNetwork                                         = "network"
@@ -479,6 +524,7 @@ const (
 	paramNetworks                                        = "networks"
 	paramNonSensitiveConfig                              = "config_nonsensitive"
 	paramNormalize                                       = "normalize"
+	paramNumPartitions                                   = "num_partitions"
 	paramOAuthBlockName                                  = "oauth"
 	paramOAuthExternalAccessToken                        = "oauth_external_access_token"
 	paramOAuthExternalClientId                           = "oauth_external_client_id"
@@ -503,8 +549,11 @@ const (
 	paramPackage                                         = "package"
 	paramParams                                          = "params"
 	paramPartition                                       = "partition"
+	paramPartitionId                                     = "partition_id"
+	paramPartitions                                      = "partitions"
 	paramPartitionsCount                                 = "partitions_count"
 	paramPassword                                        = "password"
+	paramPath                                            = "path"
 	paramPatternType                                     = "pattern_type"
 	paramPermission                                      = "permission"
 	paramPhase                                           = "phase"
@@ -513,6 +562,9 @@ const (
 	paramPhysicalName                                    = "column_physical_name"
 	paramPhysicalType                                    = "column_physical_type"
 	paramPluginId                                        = "plugin_id"
+	paramPreferredConnectionType                         = "preferred_connection_type"
+	paramPreventDestroyIfNotEmpty                        = "prevent_destroy_if_not_empty"
+	paramPreventDestroyIfNotEmptyDefaultValue            = false
 	paramPrincipal                                       = "principal"
 	paramPrincipalArn                                    = "principal_arn"
 	paramPrincipals                                      = "principals"
@@ -534,6 +586,7 @@ const (
 	paramPrivateServiceConnectEndpointTarget             = "private_service_connect_endpoint_target"
 	paramPrivateServiceConnectServiceAttachment          = "private_service_connect_service_attachment"
 	paramPrivateServiceConnectServiceAttachments         = "private_service_connect_service_attachments"
+	paramProducedRecords                                 = "produced_records"
 	paramProject                                         = "project"
 	paramProperties                                      = "properties"
 	paramPropertiesSensitive                             = "properties_sensitive"
@@ -542,7 +595,10 @@ const (
 	paramQuery                                           = "query"
 	paramRamResourceShareArn                             = "ram_resource_share_arn"
 	paramRbacCrn                                         = "rbac_crn"
+	paramRecord                                          = "record"
 	paramRecordFailureStrategy                           = "record_failure_strategy"
+	paramRecreateOnIncompatibleChange                    = "recreate_on_incompatible_change"
+	paramRecreateOnIncompatibleChangeDefaultValue        = false
 	paramRecreateOnUpdate                                = "recreate_on_update"
 	paramRecreateOnUpdateDefaultValue                    = false
 	paramRegion                                          = "region"
@@ -550,6 +606,7 @@ const (
 	paramRemoteKafkaCluster                              = "remote_kafka_cluster"
 	paramRemoteKafkaCredentials                          = "remote_kafka_cluster.0.credentials"
 	paramRequireCrlOnClientCertificate                   = "require_crl_on_client_certificate"
+	paramReplicas                                        = "replicas"
 	paramReservedCidr                                    = "reserved_cidr"
 	paramResetOnUpdate                                   = "reset_on_update"
 	paramResetOnUpdateDefaultValue                       = false
@@ -561,24 +618,33 @@ const (
 	paramResourceScope                                   = "resource_scope"
 	paramResourceType                                    = "resource_type"
 	paramRestEndpoint                                    = "rest_endpoint"
+	paramRestEndpointConnectionType                      = "rest_endpoint_connection_type"
 	paramRestEndpointPrivate                             = "private_rest_endpoint"
 	paramRestEndpointPrivateRegional                     = "private_regional_rest_endpoints"
 	paramDataRetentionMs                                 = "data_retention_ms"
 	paramPrivateLinkAccessPointResourceId                = "id"
+	paramRestoreSoftDeleted                              = "restore_soft_deleted"
+	paramRestoreSoftDeletedDefaultValue                  = false
 	paramRetentionMs                                     = "retention_ms"
 	paramRoleName                                        = "role_name"
+	paramRotateTrigger                                   = "rotate_trigger"
+	paramRotationPeriod                                  = "rotation_period"
 	paramRoutes                                          = "routes"
 	paramRuleset                                         = "ruleset"
 	paramRuntimeLanguage                                 = "runtime_language"
 	paramSchema                                          = "schema"
+	paramSchemaId                                        = "schema_id"
 	paramSchemaIdentifier                                = "schema_identifier"
 	paramSchemaReference                                 = "schema_reference"
 	paramSchemaRegistryCluster                           = "schema_registry_cluster"
+	paramSchemaVersion                                   = "schema_version"
 	paramSchemas                                         = "schemas"
 	paramSchemasFilterDeleted                            = "deleted"
 	paramSchemasFilterLatestOnly                         = "latest_only"
 	paramSchemasFilterSubjectPrefix                      = "subject_prefix"
 	paramSecret                                          = "secret"
+	paramSelectedBootstrapEndpoint                       = "selected_bootstrap_endpoint"
+	paramSelectedRestEndpoint                            = "selected_rest_endpoint"
 	paramSensitive                                       = "sensitive"
 	paramSensitiveConfig                                 = "config_sensitive"
 	paramSensitiveConfigProperties                       = "sensitive_config_properties"
@@ -589,15 +655,22 @@ const (
 	paramSessionOptions                                  = "session_options"
 	paramShared                                          = "shared"
 	paramSharedDefaultValue                              = false
+	paramSizeBytes                                       = "size_bytes"
 	paramSkipValidationDuringPlan                        = "skip_validation_during_plan"
 	paramSkipValidationDuringPlanDefaultValue            = false
 	paramSnowflake                                       = "snowflake"
+	paramSource                                          = "source"
 	paramSourceKafkaCluster                              = "source_kafka_cluster"
 	paramSourceKafkaCredentials                          = "source_kafka_cluster.0.credentials"
 	paramSourceKafkaTopic                                = "source_kafka_topic"
+	paramSourceTopicName                                 = "source_topic_name"
+	paramSourceTopicNamePrefix                           = "source_topic_name_prefix"
+	paramSourceTopicNames                                = "source_topic_names"
 	paramStandardCluster                                 = "standard"
+	paramState                                           = "state"
 	paramStatement                                       = "statement"
 	paramStatementName                                   = "statement_name"
+	paramStateTimeMs                                     = "state_time_ms"
 	paramStatus                                          = "status"
 	paramStatusDetail                                    = "status_detail"
 	paramStopped                                         = "stopped"
@@ -605,7 +678,9 @@ const (
 	paramStorageAccount                                  = "storage_account_name"
 	paramStorageRegion                                   = "storage_region"
 	paramStreamGovernance                                = "stream_governance"
+	paramSubject                                         = "subject"
 	paramSubjectName                                     = "subject_name"
+	paramSubjectNameStrategy                             = "subject_name_strategy"
 	paramSubjectRenameFormat                             = "subject_rename_format"
 	paramSubjects                                        = "subjects"
 	paramSubscription                                    = "subscription"
@@ -615,10 +690,14 @@ const (
 	paramTablePath                                       = "table_path"
 	paramTagName                                         = "tag_name"
 	paramTags                                            = "tags"
+	paramTaskName                                        = "task_name"
+	paramTasks                                           = "tasks"
 	paramTenant                                          = "tenant"
 	paramThroughput                                      = "throughput"
 	paramTopicName                                       = "topic_name"
 	paramTopicPrefix                                     = "topic_prefix"
+	paramTopicsBetweenWaits                              = "topics_between_waits"
+	paramTopicsBetweenWaitsDefaultValue                  = 20
 	paramTransitGatewayAttachmentId                      = "transit_gateway_attachment_id"
 	paramTransitGatewayId                                = "transit_gateway_id"
 	paramType                                            = "type"
@@ -627,6 +706,7 @@ const (
 	paramUseDetailedProcessingLog                        = "use_detailed_processing_log"
 	paramUser                                            = "user"
 	paramUsername                                        = "username"
+	paramValidateLocally                                 = "validate_locally"
 	paramValue                                           = "value"
 	paramVersion                                         = "version"
 	paramVersions                                        = "versions"
@@ -671,6 +751,7 @@ const (
 	saslLoginCallbackHandlerClassConfigKey    = "sasl.login.callback.handler.class"
 	saslMechanismConfigKey                    = "sasl.mechanism"
 	saslOAuthBearerTokenEndpointUrlConfigKey  = "sasl.oauthbearer.token.endpoint.url"
+	schemaBundleLoggingKey                    = "schema_bundle_id"
 	schemaEntityType                          = "sr_schema"
 	schemaExporterAPICreateTimeout            = 12 * time.Hour
 	schemaExporterLoggingKey                  = "schema_exporter_id"
@@ -686,6 +767,7 @@ If compatibility is set to one of the TRANSITIVE types, the check is against all
 	schemaRegistryDekKey                     = "dek_id"
 	schemaRegistryKekKey                     = "kek_id"
 	schemaRegistryKind                       = "SchemaRegistry"
+	schemaRegistryMigrationLoggingKey        = "schema_registry_migration_id"
 	schemaRegistryUrlConfig                  = "schema.registry.url"
 	securityProtocolConfigKey                = "security.protocol"
 	serviceAccountKind                       = "ServiceAccount"
@@ -719,7 +801,10 @@ If compatibility is set to one of the TRANSITIVE types, the check is against all
 	statePaused                              = "PAUSED"
 	statePending                             = "PENDING"
 	statePendingAccept                       = "PENDING_ACCEPT"
+	statePendingRestore                      = "PENDING_RESTORE"
+	statePendingSetupForRestore              = "PENDING_SETUP_FOR_RESTORE"
 	statePendingStopped                      = "PENDING_STOPPED"
+	statePendingSynchronize                  = "PENDING_SYNCHRONIZE"
 	stateProcessing                          = "PROCESSING"
 	statePromoted                            = "PROMOTED"
 	stateProvisioned                         = "PROVISIONED"
@@ -40,6 +40,7 @@ const (
 	aclResourceName                                                     = "kafka-cluster"
 	aclResourceType                                                     = "CLUSTER"
 	aclScenarioName                                                     = "confluent_kafka_acl Resource Lifecycle"
+	aclWithRestEndpointLookupScenarioName                               = "confluent_kafka_acl Resource With REST Endpoint Lookup Lifecycle"
 	availabilityDriftScenarioName                                       = "confluent_kafka Availability Drift"
 	awsAccountNumber                                                    = "012345678901"
 	awsDnsDomain                                                        = "pr1jy6.us-east-2.aws.confluent.cloud"
@@ -159,10 +160,13 @@ const (
 	clusterId                                                           = "lkc-190073"
 	clusterLinkConnectionMode                                           = "OUTBOUND"
 	clusterLinkDataSourceLabel                                          = "test_cluster_link_data_source_label"
+	clusterLinkFailoverResourceLabel                                    = "test_cluster_link_failover_resource_label"
+	clusterLinkFailoverScenarioName                                     = "confluent_cluster_link_failover Resource Lifecycle"
 	clusterLinkMode                                                     = "DESTINATION"
 	clusterLinkName                                                     = "ui-test"
 	clusterLinkResourceLabel                                            = "test_cluster_link_resource_label"
 	clusterLinkScenarioName                                             = "confluent_cluster_link Resource Lifecycle"
+	clusterLinkStatusDataSourceScenarioName                             = "confluent_cluster_link_status Data Source Lifecycle"
 	configResourceLabel                                                 = "test_config_resource_label"
 	configScenarioName                                                  = "confluent_kafka_cluster_config Resource Lifecycle"
 	connectArtifactCloud                                                = "AWS"
@@ -214,7 +218,13 @@ const (
 	dekDataSourceScenarioName                                           = "confluent_schema_registry_dek Data Source Lifecycle"
 	dekLabel                                                            = "confluent_schema_registry_dek.mydek"
 	dekResourceScenarioName                                             = "confluent_schema_registry_dek Resource Lifecycle"
+	dekRotationScenarioName                                             = "confluent_schema_registry_dek Rotation"
+	dekSubjectUrlPath                                                   = "/dek-registry/v1/keks/testkek/deks/ts"
 	dekUrlPath                                                          = "/dek-registry/v1/keks/testkek/deks/ts/versions/1"
+	dekV2UrlPath                                                        = "/dek-registry/v1/keks/testkek/deks/ts/versions/2"
+	dekVersionsUrlPath                                                  = "/dek-registry/v1/keks/testkek/deks/ts/versions"
+	deksDataSourceLabel                                                 = "data.confluent_schema_registry_deks.deks"
+	deksDataSourceScenarioName                                          = "confluent_schema_registry_deks Data Source Lifecycle"
 	deleteCreatedBusinessMetadataBindingSrUrlPath                       = "/catalog/v1/entity/type/sr_schema/name/lsrc-nrndwv:.:100002/businessmetadata/bm"
 	deleteCreatedBusinessMetadataBindingUrlPath                         = "/catalog/v1/entity/type/kafka_topic/name/lsrc-8wrx70:lkc-m80307:topic_0/businessmetadata/bm"
 	deleteCreatedEntityAttributesUrlPath                                = "/catalog/v1/entity"
@@ -449,6 +459,8 @@ const (
 	kafkaClientQuotaResourceLabel                                       = "test_kafka_client_quota_resource_label"
 	kafkaClientQuotaScenarioName                                        = "confluent_kafka_client_quota Resource Lifecycle"
 	kafkaClientQuotaUrlPath                                             = "/kafka-quotas/v1/client-quotas/cq-e857e"
+	kafkaClientQuotasDataSourceScenarioName                             = "confluent_kafka_client_quotas Data Source Lifecycle"
+	kafkaClientQuotasUrlPath                                            = "/kafka-quotas/v1/client-quotas"
 	kafkaCloud                                                          = "GCP"
 	kafkaClusterId                                                      = "lkc-19ynpv"
 	kafkaClustersDataSourceLabel                                        = "test_kafka_clusters_data_source_label"
@@ -464,7 +476,11 @@ const (
 	kafkaMirrorTopicNameWithPrefix                                      = "us_orders"
 	kafkaMirrorTopicResourceLabel                                       = "test_kafka_mirror_topic_resource_label"
 	kafkaMirrorTopicScenarioName                                        = "confluent_cluster_link Resource Lifecycle"
+	kafkaMirrorTopicsResourceLabel                                      = "test_kafka_mirror_topics_resource_label"
+	kafkaMirrorTopicsScenarioName                                       = "confluent_kafka_mirror_topics Resource Lifecycle"
 	kafkaNetworkId                                                      = "n-123abc"
+	kafkaRecordsResourceLabel                                           = "test_kafka_records_resource_label"
+	kafkaRecordsScenarioName                                            = "confluent_kafka_records Resource Lifecycle"
 	kafkaRbacCrn                                                        = "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-1jrymj/cloud-cluster=lkc-19ynpv"
 	kafkaRegion                                                         = "us-central1"
 	kafkaResourceLabel                                                  = "basic-cluster"
@@ -516,8 +532,9 @@ const (
 	networkLinkServiceResourceScenarioName                              = "confluent_network_link_service Resource Lifecycle"
 	networkLinkServiceUrlPath                                           = "/networking/v1/network-link-services"
 	numberOfClusterLinkDataSourceAttributes                             = "8"
-	numberOfClusterLinkResourceAttributes                               = "10"
+	numberOfClusterLinkResourceAttributes                               = "13"
 	numberOfKafkaMirrorTopicResourceAttributes                          = "6"
+	numberOfKafkaTopicResourceAttributes                                = "11"
 	numberOfResourceAttributes                                          = "7"
 	organizationDataSourceLabel                                         = "test_organization_data_source_label"
 	organizationDataSourceScenarioName                                  = "confluent_organization Data Source Lifecycle"
@@ -687,6 +704,7 @@ const (
 	scenarioStateCustomConnectorPluginVersionHasBeenDeleted             = "The new custom connector plugin version has been deleted"
 	scenarioStateCustomConnectorPluginVersionPresignedUrlHasBeenCreated = "The new custom connector plugin version's presigned URL has been just created"
 	scenarioStateDekHasBeenCreated                                      = "A new dek has been just created"
+	scenarioStateDekHasBeenRotated                                      = "A new version of the dek has been just created"
 	scenarioStateDnsForwarderHasBeenCreated                             = "The new dns forwarder has been created"
 	scenarioStateDnsForwarderIsProvisioning                             = "The new dns forwarder is provisioning"
 	scenarioStateDnsRecordHasBeenCreated                                = "The new dns record has been just created"
@@ -754,6 +772,7 @@ const (
 	scenarioStateKafkaClientQuotaHasBeenDeleted                         = "The new Kafka Client Quota has been deleted"
 	scenarioStateKafkaCreatedWithMultiZone                              = "Kafka cluster created with MULTI_ZONE"
 	scenarioStateKafkaCreatedWithSingleZone                             = "Kafka cluster created with SINGLE_ZONE"
+	scenarioStateKafkaFirstRecordHasBeenProduced                        = "The first Kafka record has been just produced"
 	scenarioStateKafkaHasBeenCreated                                    = "A new Kafka Basic cluster has been just created"
 	scenarioStateKafkaHasBeenCreatedAndSRClusterIsProvisioned           = "A new Kafka Basic cluster has been just created: SR cluster is provisioned"
 	scenarioStateKafkaHasBeenCreatedAndSyncIsComplete                   = "A new Kafka Basic cluster has been just created: sync is complete"
@@ -761,14 +780,21 @@ const (
 	scenarioStateKafkaHasBeenCreatedButZeroSRClusters                   = "A new Kafka Basic cluster has been just created: waiting for SR cluster to appear"
 	scenarioStateKafkaHasBeenDeleted                                    = "The new Kafka cluster has been deleted"
 	scenarioStateKafkaHasBeenUpdated                                    = "The new Kafka cluster's kind has been just updated to Standard"
+	scenarioStateKafkaMirrorTopicFailoverHasFailed                      = "Failing over the Kafka Mirror Topic has just failed"
 	scenarioStateKafkaMirrorTopicHasBeenCreated                         = "A new Kafka Mirror Topic has been just created"
 	scenarioStateKafkaMirrorTopicHasBeenDeleted                         = "The Kafka Mirror Topic has been deleted"
 	scenarioStateKafkaMirrorTopicHasBeenPaused                          = "The Kafka Mirror Topic has been paused"
 	scenarioStateKafkaMirrorTopicHasBeenStopped                         = "The Kafka Mirror Topic has been stopped"
+	scenarioStateKafkaMirrorTopicsHaveBeenCreated                       = "New Kafka Mirror Topics have been just created"
+	scenarioStateKafkaMirrorTopicsHaveBeenDeleted                       = "The Kafka Mirror Topics have been deleted"
+	scenarioStateKafkaMirrorTopicsHaveBeenFailedOver                    = "The Kafka Mirror Topics have been failed over"
+	scenarioStateKafkaOrdersMirrorTopicHasBeenCreated                   = "The Kafka Mirror Topic of orders has been just created"
+	scenarioStateKafkaPaymentsMirrorTopicHasFailed                      = "The creation of the Kafka Mirror Topic of payments has failed"
 	scenarioStateKafkaProvisionedMultiZone                              = "Kafka cluster provisioned with MULTI_ZONE"
 	scenarioStateKafkaProvisionedSingleZone                             = "Kafka cluster provisioned with SINGLE_ZONE"
 	scenarioStateKafkaReadyForHighTransition                            = "Kafka cluster ready for HIGH transition"
 	scenarioStateKafkaReadyForLowTransition                             = "Kafka cluster ready for LOW transition"
+	scenarioStateKafkaSecondRecordHasFailed                             = "Producing the second Kafka record has just failed"
 	scenarioStateKekHasBeenCreated                                      = "A new kek has been just created"
 	scenarioStateKekHasBeenUpdated                                      = "A new kek has been just updated"
 	scenarioStateKsqlHasBeenCreated                                     = "A new ksqlDB cluster has been just created"
@@ -824,6 +850,7 @@ const (
 	scenarioStateSchemaRegistryClusterModeHasBeenCreated                = "A new subject mode has been just created"
 	scenarioStateSchemaRegistryClusterModeHasBeenDeleted                = "The subject mode has been deleted"
 	scenarioStateSchemaRegistryClusterModeHasBeenUpdated                = "The subject mode has been updated"
+	scenarioStateSchemaRegistryMigrationHasFailed                       = "Registering the second schema of the migration has just failed"
 	scenarioStateSchemaTest1Part2                                       = "In Test 1 part 2"
 	scenarioStateSchemaTest2                                            = "In test 2 part 1"
 	scenarioStateStatementHasBeenCreated                                = "A new statement has been just created"
@@ -876,6 +903,9 @@ const (
 	schemaRegistryClusterPrivateEndpointRegionalValue                   = "https://lsrc-stk1d.us-east-1.aws.private.confluent.cloud"
 	schemaRegistryClusterRegionId                                       = "us-east4"
 	schemaRegistryClusterResourceName                                   = "crn://confluent.cloud/organization=1111aaaa-11aa-11aa-11aa-111111aaaaaa/environment=env-1jrymj/schema-registry=lsrc-755ogo"
+	schemaRegistryMigrationDestinationPath                              = "/destination"
+	schemaRegistryMigrationLabel                                        = "confluent_schema_registry_migration.main"
+	schemaRegistryMigrationScenarioName                                 = "confluent_schema_registry_migration Resource Lifecycle"
 	schemaScenarioName                                                  = "confluent_schema Resource Lifecycle"
 	schemasDataSourceScenarioName                                       = "confluent_schemas Data Source Lifecycle"
 	secondClusterClusterLinkConfigName                                  = "consumer.offset.sync.ms"
@@ -1059,11 +1089,12 @@ const (
 	testNumberOfSchemaRegistryClusterCompatibilityLevelResourceAttributes   = "7"
 	testNumberOfSchemaRegistryClusterModeDataSourceAttributes               = 5
 	testNumberOfSchemaRegistryClusterModeResourceAttributes                 = "6"
-	testNumberOfSchemaRegistrySchemaResourceAttributes                      = 15
-	testNumberOfSubjectCompatibilityLevelDataSourceAttributes               = 9
-	testNumberOfSubjectCompatibilityLevelResourceAttributes                 = "9"
-	testNumberOfSubjectModeDataSourceAttributes                             = 6
-	testNumberOfSubjectModeResourceAttributes                               = "7"
+	testNumberOfSchemaRegistrySchemaDataSourceAttributes                    = 16
+	testNumberOfSchemaRegistrySchemaResourceAttributes                      = 18
+	testNumberOfSubjectCompatibilityLevelDataSourceAttributes               = 10
+	testNumberOfSubjectCompatibilityLevelResourceAttributes                 = "11"
+	testNumberOfSubjectModeDataSourceAttributes                             = 7
+	testNumberOfSubjectModeResourceAttributes                               = "8"
 	testOriginalDestinationSchemaRegistryRestEndpoint                       = "https://psrc-4xgzx.us-east-2.aws.confluent.cloud"
 	testRecreateOnUpdateFalse                                               = "false"
 	testRecreateOnUpdateTrue                                                = "true"
@@ -1137,6 +1168,7 @@ message SomeRecord {
 	thirdZoneSubdomainAzureNetwork                     = "az3.p8xo76.centralus.azure.confluent.cloud"
 	thirdZoneSubdomainGcpNetwork                       = "us-central1-c.6ky22p.us-central1.gcp.confluent.cloud"
 	topicDataSourceScenarioName                        = "confluent_kafka_topic Data Source Lifecycle"
+	topicPartitionsDataSourceScenarioName              = "confluent_kafka_topic_partitions Data Source Lifecycle"
 	topicName                                          = "test_topic_name"
 	topicResourceLabel                                 = "test_topic_resource_label"
 	topicScenarioName                                  = "confluent_kafka_topic Resource Lifecycle"
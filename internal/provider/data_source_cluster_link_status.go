@@ -0,0 +1,338 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// clusterLinkStatusData describes the operational status of a cluster link.
+// Kafka REST API client doesn't expose the tasks of a cluster link, so it's defined here.
+type clusterLinkStatusData struct {
+	ClusterLinkId    string                `json:"cluster_link_id"`
+	LinkName         string                `json:"link_name"`
+	LinkState        string                `json:"link_state,omitempty"`
+	LinkError        string                `json:"link_error,omitempty"`
+	LinkErrorMessage *string               `json:"link_error_message,omitempty"`
+	Tasks            []clusterLinkTaskData `json:"tasks,omitempty"`
+}
+
+type clusterLinkTaskData struct {
+	TaskName string                     `json:"task_name"`
+	State    string                     `json:"state"`
+	Errors   []clusterLinkTaskErrorData `json:"errors,omitempty"`
+}
+
+type clusterLinkTaskErrorData struct {
+	ErrorCode    string `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+}
+
+func clusterLinkStatusDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: clusterLinkStatusDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramId: {
+				Type:        schema.TypeString,
+				Description: "The composite ID of the Cluster Link Status data-source, in the format <Kafka cluster ID>/<Cluster Link name>.",
+				Computed:    true,
+			},
+			paramKafkaCluster: optionalKafkaClusterBlockDataSourceSchema(),
+			paramRestEndpoint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The REST endpoint of the Kafka cluster (e.g., `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+			},
+			paramCredentials: credentialsSchema(),
+			paramLinkName: {
+				Type:        schema.TypeString,
+				Description: "The name of the Cluster Link.",
+				Required:    true,
+			},
+			paramClusterLinkId: {
+				Type:        schema.TypeString,
+				Description: "The actual Cluster Link ID assigned from Confluent Cloud that uniquely represents a link between two Kafka clusters.",
+				Computed:    true,
+			},
+			paramLinkState: {
+				Type:        schema.TypeString,
+				Description: "The current state of the Cluster Link.",
+				Computed:    true,
+			},
+			paramLinkError: {
+				Type:        schema.TypeString,
+				Description: "The error code of the Cluster Link, or `NO_ERROR` if the Cluster Link is healthy.",
+				Computed:    true,
+			},
+			paramLinkErrorMessage: {
+				Type:        schema.TypeString,
+				Description: "The error message of the Cluster Link.",
+				Computed:    true,
+			},
+			paramTasks:        clusterLinkTasksSchema(),
+			paramMirrorTopics: clusterLinkMirrorTopicsSchema(),
+			paramMaxLag: {
+				Type:        schema.TypeInt,
+				Description: "The maximum lag across all partitions of all mirror topics of the Cluster Link.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func clusterLinkTasksSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramTaskName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				paramState: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				paramErrors: {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							paramErrorCode: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							paramErrorMessage: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func clusterLinkMirrorTopicsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramMirrorTopicName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				paramSourceTopicName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				paramNumPartitions: {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				paramMirrorStatus: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				paramStateTimeMs: {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The time of the last change of the mirror status, in milliseconds since the epoch.",
+				},
+				paramMaxLag: {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The maximum lag across all partitions of the mirror topic.",
+				},
+				paramMirrorLags: {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							paramPartition: {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							paramLag: {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							paramLastSourceFetchOffset: {
+								Type:        schema.TypeInt,
+								Computed:    true,
+								Description: "The offset of the source partition that was last fetched, or -1 if nothing has been fetched yet.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func clusterLinkStatusDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := dataSourceCredentialBlockValidationWithOAuth(d, meta.(*Client).isOAuthEnabled); err != nil {
+		return diag.Errorf("error reading Cluster Link Status: %s", createDescriptiveError(err))
+	}
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Cluster Link Status: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Cluster Link Status: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Cluster Link Status: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, false, false, meta.(*Client).oauthToken)
+
+	linkName := d.Get(paramLinkName).(string)
+	compositeClusterLinkId := createClusterLinkCompositeId(clusterId, linkName)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Cluster Link Status %q", compositeClusterLinkId), map[string]interface{}{clusterLinkLoggingKey: compositeClusterLinkId})
+
+	var clusterLinkStatus clusterLinkStatusData
+	resp, err := kafkaRestClient.executeRawRequest(ctx, http.MethodGet, fmt.Sprintf("/kafka/v3/clusters/%s/links/%s", url.PathEscape(kafkaRestClient.clusterId), url.PathEscape(linkName)), nil, &clusterLinkStatus)
+	if err != nil {
+		return diag.Errorf("error reading Cluster Link Status %q: %s", compositeClusterLinkId, createDescriptiveError(err, resp))
+	}
+	clusterLinkStatusJson, err := json.Marshal(clusterLinkStatus)
+	if err != nil {
+		return diag.Errorf("error reading Cluster Link Status %q: error marshaling %#v to json: %s", compositeClusterLinkId, clusterLinkStatus, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Cluster Link Status %q: %s", compositeClusterLinkId, clusterLinkStatusJson), map[string]interface{}{clusterLinkLoggingKey: compositeClusterLinkId})
+
+	mirrorTopics, resp, err := kafkaRestClient.apiClient.ClusterLinkingV3Api.ListKafkaMirrorTopicsUnderLink(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId, linkName).Execute()
+	if err != nil {
+		return diag.Errorf("error reading mirror topics of Cluster Link %q: %s", compositeClusterLinkId, createDescriptiveError(err, resp))
+	}
+	mirrorTopicsJson, err := json.Marshal(mirrorTopics)
+	if err != nil {
+		return diag.Errorf("error reading mirror topics of Cluster Link %q: error marshaling %#v to json: %s", compositeClusterLinkId, mirrorTopics, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched mirror topics of Cluster Link %q: %s", compositeClusterLinkId, mirrorTopicsJson), map[string]interface{}{clusterLinkLoggingKey: compositeClusterLinkId})
+
+	if err := setClusterLinkStatusAttributes(d, clusterLinkStatus, mirrorTopics.Data); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	d.SetId(compositeClusterLinkId)
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Cluster Link Status %q", d.Id()), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+
+	return nil
+}
+
+func setClusterLinkStatusAttributes(d *schema.ResourceData, clusterLinkStatus clusterLinkStatusData, mirrorTopics []kafkarestv3.ListMirrorTopicsResponseData) error {
+	if err := d.Set(paramClusterLinkId, clusterLinkStatus.ClusterLinkId); err != nil {
+		return err
+	}
+	if err := d.Set(paramLinkState, clusterLinkStatus.LinkState); err != nil {
+		return err
+	}
+	if err := d.Set(paramLinkError, clusterLinkStatus.LinkError); err != nil {
+		return err
+	}
+	linkErrorMessage := ""
+	if clusterLinkStatus.LinkErrorMessage != nil {
+		linkErrorMessage = *clusterLinkStatus.LinkErrorMessage
+	}
+	if err := d.Set(paramLinkErrorMessage, linkErrorMessage); err != nil {
+		return err
+	}
+	if err := d.Set(paramTasks, populateClusterLinkTasksResult(clusterLinkStatus.Tasks)); err != nil {
+		return err
+	}
+	mirrorTopicsResult, maxLag := populateClusterLinkMirrorTopicsResult(mirrorTopics)
+	if err := d.Set(paramMirrorTopics, mirrorTopicsResult); err != nil {
+		return err
+	}
+	return d.Set(paramMaxLag, maxLag)
+}
+
+func populateClusterLinkTasksResult(tasks []clusterLinkTaskData) []interface{} {
+	result := make([]interface{}, len(tasks))
+	for i, task := range tasks {
+		taskErrors := make([]interface{}, len(task.Errors))
+		for j, taskError := range task.Errors {
+			taskErrors[j] = map[string]interface{}{
+				paramErrorCode:    taskError.ErrorCode,
+				paramErrorMessage: taskError.ErrorMessage,
+			}
+		}
+		result[i] = map[string]interface{}{
+			paramTaskName: task.TaskName,
+			paramState:    task.State,
+			paramErrors:   taskErrors,
+		}
+	}
+	return result
+}
+
+// populateClusterLinkMirrorTopicsResult returns mirror topics sorted by name, and the maximum lag across all of them.
+func populateClusterLinkMirrorTopicsResult(mirrorTopics []kafkarestv3.ListMirrorTopicsResponseData) ([]interface{}, int) {
+	sort.Slice(mirrorTopics, func(i, j int) bool {
+		return mirrorTopics[i].MirrorTopicName < mirrorTopics[j].MirrorTopicName
+	})
+
+	var maxLag int64
+	result := make([]interface{}, len(mirrorTopics))
+	for i, mirrorTopic := range mirrorTopics {
+		mirrorLags := mirrorTopic.MirrorLags.Items
+		sort.Slice(mirrorLags, func(i, j int) bool {
+			return mirrorLags[i].Partition < mirrorLags[j].Partition
+		})
+		var mirrorTopicMaxLag int64
+		mirrorLagsResult := make([]interface{}, len(mirrorLags))
+		for j, mirrorLag := range mirrorLags {
+			if mirrorLag.Lag > mirrorTopicMaxLag {
+				mirrorTopicMaxLag = mirrorLag.Lag
+			}
+			mirrorLagsResult[j] = map[string]interface{}{
+				paramPartition:             int(mirrorLag.Partition),
+				paramLag:                   int(mirrorLag.Lag),
+				paramLastSourceFetchOffset: int(mirrorLag.LastSourceFetchOffset),
+			}
+		}
+		if mirrorTopicMaxLag > maxLag {
+			maxLag = mirrorTopicMaxLag
+		}
+		result[i] = map[string]interface{}{
+			paramMirrorTopicName: mirrorTopic.MirrorTopicName,
+			paramSourceTopicName: mirrorTopic.SourceTopicName,
+			paramNumPartitions:   int(mirrorTopic.NumPartitions),
+			paramMirrorStatus:    string(mirrorTopic.MirrorStatus),
+			paramStateTimeMs:     int(mirrorTopic.StateTimeMs),
+			paramMaxLag:          int(mirrorTopicMaxLag),
+			paramMirrorLags:      mirrorLagsResult,
+		}
+	}
+	return result, int(maxLag)
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+var fullClusterLinkStatusDataSourceLabel = fmt.Sprintf("data.confluent_cluster_link_status.%s", clusterLinkDataSourceLabel)
+var listKafkaMirrorTopicsUnderLinkPath = fmt.Sprintf("/kafka/v3/clusters/%s/links/%s/mirrors", sourceClusterId, clusterLinkName)
+
+func TestAccDataSourceClusterLinkStatus(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockClusterLinkTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockClusterLinkTestServerUrl)
+
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readClusterLinkStatusResponse, _ := ioutil.ReadFile("../testdata/cluster_link_status/read_cluster_link_status.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readClusterLinkSourceOutboundPath)).
+		InScenario(clusterLinkStatusDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readClusterLinkStatusResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	listMirrorTopicsResponse, _ := ioutil.ReadFile("../testdata/cluster_link_status/list_kafka_mirror_topics.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(listKafkaMirrorTopicsUnderLinkPath)).
+		InScenario(clusterLinkStatusDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listMirrorTopicsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckClusterLinkStatusDataSourceConfig(confluentCloudBaseUrl, mockClusterLinkTestServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "id", fmt.Sprintf("%s/%s", sourceClusterId, clusterLinkName)),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "link_name", clusterLinkName),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "cluster_link_id", "qz0HDEV-Qz2B5aPFpcWQJQ"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "link_state", "ACTIVE"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "link_error", "NO_ERROR"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "link_error_message", ""),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "tasks.#", "2"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "tasks.0.task_name", "AutoCreateMirrorTopics"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "tasks.0.state", "ACTIVE"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "tasks.0.errors.#", "0"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "tasks.1.task_name", "ConsumerOffsetSync"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "tasks.1.state", "IN_ERROR"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "tasks.1.errors.#", "1"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "tasks.1.errors.0.error_code", "AUTHORIZATION_FAILED"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "tasks.1.errors.0.error_message", "Authorization failed while describing consumer groups on the source cluster."),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "max_lag", "15"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.#", "2"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.mirror_topic_name", "orders"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.source_topic_name", "orders"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.num_partitions", "2"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.mirror_status", "ACTIVE"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.state_time_ms", "1662878304410"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.max_lag", "15"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.mirror_lags.#", "2"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.mirror_lags.0.partition", "0"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.mirror_lags.0.lag", "3"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.mirror_lags.0.last_source_fetch_offset", "981"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.mirror_lags.1.partition", "1"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.mirror_lags.1.lag", "15"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.0.mirror_lags.1.last_source_fetch_offset", "1270"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.1.mirror_topic_name", "payments"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.1.mirror_status", "PAUSED"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.1.max_lag", "0"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.1.mirror_lags.#", "1"),
+					resource.TestCheckResourceAttr(fullClusterLinkStatusDataSourceLabel, "mirror_topics.1.mirror_lags.0.last_source_fetch_offset", "-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClusterLinkStatusDataSourceConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+	  endpoint = "%s"
+	}
+	data "confluent_cluster_link_status" "%s" {
+	  link_name = "%s"
+      rest_endpoint = "%s"
+	  kafka_cluster {
+        id = "%s"
+      }
+      credentials {
+		key = "%s"
+		secret = "%s"
+	  }
+	}
+	`, confluentCloudBaseUrl, clusterLinkDataSourceLabel,
+		clusterLinkName, mockServerUrl, sourceClusterId, sourceClusterApiKey, sourceClusterApiSecret)
+}
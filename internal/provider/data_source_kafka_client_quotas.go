@@ -0,0 +1,172 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	kafkaquotasv1 "github.com/confluentinc/ccloud-sdk-go-v2/kafka-quotas/v1"
+)
+
+func kafkaClientQuotasDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: kafkaClientQuotasDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: requiredKafkaClusterDataSourceSchema(),
+			paramEnvironment:  environmentDataSourceSchema(),
+			paramClientQuotas: kafkaClientQuotasSchema(),
+		},
+	}
+}
+
+func kafkaClientQuotasSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramId: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				paramDisplayName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				paramDescription: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				paramPrincipals: {
+					Type:     schema.TypeSet,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					Computed: true,
+				},
+				paramThroughput: {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							paramIngressByteRate: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							paramEgressByteRate: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+			},
+		},
+		Description: "The client quotas of the Kafka cluster, sorted by ID.",
+	}
+}
+
+func kafkaClientQuotasDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
+	environmentId := extractStringValueFromBlock(d, paramEnvironment, paramId)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka client quotas of Kafka Cluster %q", clusterId))
+
+	c := meta.(*Client)
+	kafkaClientQuotas, err := loadKafkaClientQuotas(ctx, c, environmentId, clusterId)
+	if err != nil {
+		return diag.Errorf("error reading Kafka client quotas of Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
+	}
+	sort.Slice(kafkaClientQuotas, func(i, j int) bool {
+		return kafkaClientQuotas[i].GetId() < kafkaClientQuotas[j].GetId()
+	})
+
+	result := make([]interface{}, len(kafkaClientQuotas))
+	for i, kafkaClientQuota := range kafkaClientQuotas {
+		spec := kafkaClientQuota.GetSpec()
+		throughput := spec.GetThroughput()
+		result[i] = map[string]interface{}{
+			paramId:          kafkaClientQuota.GetId(),
+			paramDisplayName: spec.GetDisplayName(),
+			paramDescription: spec.GetDescription(),
+			paramPrincipals:  extractKafkaClientQuotaPrincipals(kafkaClientQuota),
+			paramThroughput: []interface{}{map[string]interface{}{
+				paramIngressByteRate: throughput.GetIngressByteRate(),
+				paramEgressByteRate:  throughput.GetEgressByteRate(),
+			}},
+		}
+	}
+	if err := d.Set(paramClientQuotas, result); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", environmentId, clusterId))
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading %d Kafka client quotas of Kafka Cluster %q", len(kafkaClientQuotas), clusterId))
+
+	return nil
+}
+
+func loadKafkaClientQuotas(ctx context.Context, c *Client, environmentId, clusterId string) ([]kafkaquotasv1.KafkaQuotasV1ClientQuota, error) {
+	kafkaClientQuotas := make([]kafkaquotasv1.KafkaQuotasV1ClientQuota, 0)
+
+	allKafkaClientQuotasAreCollected := false
+	pageToken := ""
+	for !allKafkaClientQuotasAreCollected {
+		kafkaClientQuotasPageList, resp, err := executeListKafkaClientQuotas(ctx, c, environmentId, clusterId, pageToken)
+		if err != nil {
+			return nil, fmt.Errorf("error reading Kafka client quotas: %s", createDescriptiveError(err, resp))
+		}
+		kafkaClientQuotas = append(kafkaClientQuotas, kafkaClientQuotasPageList.GetData()...)
+
+		// nextPageUrlStringNullable is nil for the last page
+		nextPageUrlStringNullable := kafkaClientQuotasPageList.GetMetadata().Next
+
+		if nextPageUrlStringNullable.IsSet() {
+			nextPageUrlString := *nextPageUrlStringNullable.Get()
+			if nextPageUrlString == "" {
+				allKafkaClientQuotasAreCollected = true
+			} else {
+				pageToken, err = extractPageToken(nextPageUrlString)
+				if err != nil {
+					return nil, fmt.Errorf("error reading Kafka client quotas: %s", createDescriptiveError(err, resp))
+				}
+			}
+		} else {
+			allKafkaClientQuotasAreCollected = true
+		}
+	}
+	return kafkaClientQuotas, nil
+}
+
+func executeListKafkaClientQuotas(ctx context.Context, c *Client, environmentId, clusterId, pageToken string) (kafkaquotasv1.KafkaQuotasV1ClientQuotaList, *http.Response, error) {
+	request := c.kafkaQuotasV1Client.ClientQuotasKafkaQuotasV1Api.ListKafkaQuotasV1ClientQuotas(c.kafkaQuotasV1ApiContext(ctx)).SpecCluster(clusterId).Environment(environmentId).PageSize(listKafkaClientQuotasPageSize)
+	if pageToken != "" {
+		request = request.PageToken(pageToken)
+	}
+	return request.Execute()
+}
+
+func extractKafkaClientQuotaPrincipals(kafkaClientQuota kafkaquotasv1.KafkaQuotasV1ClientQuota) []string {
+	principalsRefs := kafkaClientQuota.Spec.GetPrincipals()
+	principalsIds := make([]string, len(principalsRefs))
+	for i, ref := range principalsRefs {
+		principalsIds[i] = ref.GetId()
+	}
+	return principalsIds
+}
@@ -0,0 +1,102 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+func TestAccDataSourceKafkaClientQuotas(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	listKafkaClientQuotasResponse, _ := ioutil.ReadFile("../testdata/kafka_client_quota/list_kafka_client_quotas.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaClientQuotasUrlPath)).
+		InScenario(kafkaClientQuotasDataSourceScenarioName).
+		WithQueryParam("spec.cluster", wiremock.EqualTo(kafkaClientQuotaClusterId)).
+		WithQueryParam("environment", wiremock.EqualTo(kafkaClientQuotaEnvrionmentId)).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listKafkaClientQuotasResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	fullKafkaClientQuotasDataSourceLabel := fmt.Sprintf("data.confluent_kafka_client_quotas.%s", kafkaClientQuotaResourceLabel)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckKafkaClientQuotasDataSourceConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, paramId, fmt.Sprintf("%s/%s", kafkaClientQuotaEnvrionmentId, kafkaClientQuotaClusterId)),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.#", "2"),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.0.id", "cq-a1b2c"),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.0.display_name", "DefaultQuota"),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.0.principals.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.0.principals.*", "<default>"),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.0.throughput.0.ingress_byte_rate", "1024"),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.0.throughput.0.egress_byte_rate", "2048"),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.1.id", kafkaClientQuotaId),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.1.display_name", kafkaClientQuotaDisplayName),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.1.description", kafkaClientQuotaDescription),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.1.principals.#", "2"),
+					resource.TestCheckTypeSetElemAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.1.principals.*", kafkaClientQuotaPrincipals[0]),
+					resource.TestCheckTypeSetElemAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.1.principals.*", kafkaClientQuotaPrincipals[1]),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.1.throughput.0.ingress_byte_rate", kafkaClientQuotaIngressByteRate),
+					resource.TestCheckResourceAttr(fullKafkaClientQuotasDataSourceLabel, "client_quotas.1.throughput.0.egress_byte_rate", kafkaClientQuotaEgressByteRate),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKafkaClientQuotasDataSourceConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+		endpoint = "%s"
+	}
+	data "confluent_kafka_client_quotas" "%s" {
+		kafka_cluster {
+			id = "%s"
+		}
+		environment {
+			id = "%s"
+		}
+	}
+	`, mockServerUrl, kafkaClientQuotaResourceLabel, kafkaClientQuotaClusterId, kafkaClientQuotaEnvrionmentId)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	cmkv2 "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
 )
@@ -118,6 +119,28 @@ func kafkaDataSource() *schema.Resource {
 				Computed:    true,
 				Description: "A map of endpoints for connecting to the Kafka cluster, keyed by access_point_id. Access Point ID 'public' and 'privatelink' are reserved. These can be used for different network access methods or regions.",
 			},
+			paramPreferredConnectionType: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{paramAccessPointID},
+				ValidateFunc:  validation.StringIsNotEmpty,
+				Description:   "The connection type of the endpoints to select, for example, `PUBLIC` or `PRIVATE_LINK`.",
+			},
+			paramAccessPointID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{paramPreferredConnectionType},
+				ValidateFunc:  validation.StringIsNotEmpty,
+				Description:   "The ID of the access point of the endpoints to select.",
+			},
+			paramSelectedBootstrapEndpoint: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			paramSelectedRestEndpoint: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -92,6 +92,7 @@ func TestAccDataSourceCluster(t *testing.T) {
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "network.#", "1"),
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "network.0.id", kafkaNetworkId),
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "rest_endpoint", kafkaHttpEndpoint),
+					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "selected_rest_endpoint", kafkaHttpEndpoint),
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "rbac_crn", kafkaRbacCrn),
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "deletion_protection", "false"),
 				),
@@ -114,6 +115,7 @@ func TestAccDataSourceCluster(t *testing.T) {
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "environment.#", "1"),
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "environment.0.id", testEnvironmentId),
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "rest_endpoint", kafkaHttpEndpoint),
+					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "selected_rest_endpoint", kafkaHttpEndpoint),
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "rbac_crn", kafkaRbacCrn),
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "endpoints.#", "0"),
 					resource.TestCheckResourceAttr(fullKafkaDataSourceLabel, "deletion_protection", "false"),
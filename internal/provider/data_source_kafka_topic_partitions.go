@@ -0,0 +1,223 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// Kafka uses -1 as the broker ID of the leader of a partition that has no leader
+	noLeaderBrokerId = -1
+)
+
+// replicaLogDirData describes the log directory of a partition replica.
+// Kafka REST API client doesn't support the log-dirs endpoint, so it's defined here.
+type replicaLogDirData struct {
+	PartitionId int32  `json:"partition_id"`
+	BrokerId    int32  `json:"broker_id"`
+	Path        string `json:"path,omitempty"`
+	Size        int64  `json:"size"`
+}
+
+type replicaLogDirDataList struct {
+	Data []replicaLogDirData `json:"data"`
+}
+
+func kafkaTopicPartitionsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: kafkaTopicPartitionsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: optionalKafkaClusterBlockDataSourceSchema(),
+			paramTopicName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			paramRestEndpoint: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			paramCredentials: credentialsSchema(),
+			paramPartitions:  kafkaTopicPartitionsSchema(),
+		},
+	}
+}
+
+func kafkaTopicPartitionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramPartitionId: {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				paramLeader: {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The ID of the broker that is the leader of the partition, or -1 if the partition has no leader.",
+				},
+				paramReplicas: {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeInt,
+					},
+					Description: "The IDs of the brokers that host replicas of the partition.",
+				},
+				paramInSyncReplicas: {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeInt,
+					},
+					Description: "The IDs of the brokers that host in-sync replicas of the partition.",
+				},
+				paramEarliestOffset: {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The log start offset of the leader replica of the partition.",
+				},
+				paramLatestOffset: {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The log end offset of the leader replica of the partition.",
+				},
+				paramSizeBytes: {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "The total size of all replicas of the partition in bytes.",
+				},
+			},
+		},
+	}
+}
+
+func kafkaTopicPartitionsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := dataSourceCredentialBlockValidationWithOAuth(d, meta.(*Client).isOAuthEnabled); err != nil {
+		return diag.Errorf("error reading Kafka Topic Partitions: %s", createDescriptiveError(err))
+	}
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topic Partitions: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topic Partitions: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topic Partitions: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaClusterIdSet, meta.(*Client).isKafkaMetadataSet, meta.(*Client).oauthToken)
+	topicName := d.Get(paramTopicName).(string)
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Topic Partitions of Kafka Topic %q", topicName))
+
+	partitions, resp, err := kafkaRestClient.apiClient.PartitionV3Api.ListKafkaPartitions(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId, topicName).Execute()
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topic Partitions of Kafka Topic %q: %s", topicName, createDescriptiveError(err, resp))
+	}
+	partitionsJson, err := json.Marshal(partitions)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Topic Partitions of Kafka Topic %q: error marshaling %#v to json: %s", topicName, partitions, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Kafka Topic Partitions of Kafka Topic %q: %s", topicName, partitionsJson))
+
+	var replicaStatuses kafkarestv3.ReplicaStatusDataList
+	resp, err = kafkaRestClient.executeRawRequest(ctx, http.MethodGet, fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s/partitions/-/replica-status", url.PathEscape(kafkaRestClient.clusterId), url.PathEscape(topicName)), nil, &replicaStatuses)
+	if err != nil {
+		return diag.Errorf("error reading replica statuses of Kafka Topic %q: %s", topicName, createDescriptiveError(err, resp))
+	}
+
+	var replicaLogDirs replicaLogDirDataList
+	resp, err = kafkaRestClient.executeRawRequest(ctx, http.MethodGet, fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s/partitions/-/log-dirs", url.PathEscape(kafkaRestClient.clusterId), url.PathEscape(topicName)), nil, &replicaLogDirs)
+	if err != nil {
+		return diag.Errorf("error reading log directories of Kafka Topic %q: %s", topicName, createDescriptiveError(err, resp))
+	}
+
+	if err := d.Set(paramPartitions, populateKafkaTopicPartitionsResult(partitions.Data, replicaStatuses.Data, replicaLogDirs.Data)); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	if !kafkaRestClient.isClusterIdSetInProviderBlock {
+		if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, kafkaRestClient.clusterId, d); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+	}
+	d.SetId(createKafkaTopicId(kafkaRestClient.clusterId, topicName))
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka Topic Partitions of Kafka Topic %q", topicName))
+
+	return nil
+}
+
+func populateKafkaTopicPartitionsResult(partitions []kafkarestv3.PartitionData, replicaStatuses []kafkarestv3.ReplicaStatusData, replicaLogDirs []replicaLogDirData) []interface{} {
+	replicaStatusesByPartition := make(map[int32][]kafkarestv3.ReplicaStatusData)
+	for _, replicaStatus := range replicaStatuses {
+		replicaStatusesByPartition[replicaStatus.PartitionId] = append(replicaStatusesByPartition[replicaStatus.PartitionId], replicaStatus)
+	}
+	sizeBytesByPartition := make(map[int32]int64)
+	for _, replicaLogDir := range replicaLogDirs {
+		sizeBytesByPartition[replicaLogDir.PartitionId] += replicaLogDir.Size
+	}
+
+	sort.Slice(partitions, func(i, j int) bool {
+		return partitions[i].PartitionId < partitions[j].PartitionId
+	})
+
+	result := make([]interface{}, len(partitions))
+	for i, partition := range partitions {
+		leader := noLeaderBrokerId
+		replicas := make([]int, 0)
+		inSyncReplicas := make([]int, 0)
+		var earliestOffset, latestOffset int64
+		partitionReplicaStatuses := replicaStatusesByPartition[partition.PartitionId]
+		sort.Slice(partitionReplicaStatuses, func(i, j int) bool {
+			return partitionReplicaStatuses[i].BrokerId < partitionReplicaStatuses[j].BrokerId
+		})
+		for _, replicaStatus := range partitionReplicaStatuses {
+			replicas = append(replicas, int(replicaStatus.BrokerId))
+			if replicaStatus.IsInIsr {
+				inSyncReplicas = append(inSyncReplicas, int(replicaStatus.BrokerId))
+			}
+			if replicaStatus.IsLeader {
+				leader = int(replicaStatus.BrokerId)
+				earliestOffset = replicaStatus.LogStartOffset
+				latestOffset = replicaStatus.LogEndOffset
+			}
+		}
+		result[i] = map[string]interface{}{
+			paramPartitionId:    int(partition.PartitionId),
+			paramLeader:         leader,
+			paramReplicas:       replicas,
+			paramInSyncReplicas: inSyncReplicas,
+			paramEarliestOffset: int(earliestOffset),
+			paramLatestOffset:   int(latestOffset),
+			paramSizeBytes:      int(sizeBytesByPartition[partition.PartitionId]),
+		}
+	}
+	return result
+}
@@ -0,0 +1,137 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+var fullTopicPartitionsDataSourceLabel = fmt.Sprintf("data.confluent_kafka_topic_partitions.%s", topicResourceLabel)
+var listKafkaPartitionsPath = fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s/partitions", clusterId, topicName)
+var listKafkaReplicaStatusesPath = fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s/partitions/-/replica-status", clusterId, topicName)
+var listKafkaReplicaLogDirsPath = fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s/partitions/-/log-dirs", clusterId, topicName)
+
+func TestAccDataSourceTopicPartitions(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockTopicTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockTopicTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	listPartitionsResponse, _ := ioutil.ReadFile("../testdata/kafka_topic_partitions/list_kafka_partitions.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(listKafkaPartitionsPath)).
+		InScenario(topicPartitionsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listPartitionsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	listReplicaStatusesResponse, _ := ioutil.ReadFile("../testdata/kafka_topic_partitions/list_kafka_replica_statuses.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(listKafkaReplicaStatusesPath)).
+		InScenario(topicPartitionsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listReplicaStatusesResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	listReplicaLogDirsResponse, _ := ioutil.ReadFile("../testdata/kafka_topic_partitions/list_kafka_replica_log_dirs.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(listKafkaReplicaLogDirsPath)).
+		InScenario(topicPartitionsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listReplicaLogDirsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceTopicPartitionsConfig(confluentCloudBaseUrl, mockTopicTestServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "id", fmt.Sprintf("%s/%s", clusterId, topicName)),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "kafka_cluster.0.id", clusterId),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "topic_name", topicName),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.#", "2"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.partition_id", "0"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.leader", "1"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.replicas.#", "3"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.replicas.0", "1"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.replicas.1", "2"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.replicas.2", "3"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.in_sync_replicas.#", "2"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.in_sync_replicas.0", "1"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.in_sync_replicas.1", "3"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.earliest_offset", "10"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.latest_offset", "250"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.0.size_bytes", "5120"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.1.partition_id", "1"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.1.leader", "2"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.1.replicas.#", "3"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.1.in_sync_replicas.#", "3"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.1.earliest_offset", "0"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.1.latest_offset", "42"),
+					resource.TestCheckResourceAttr(fullTopicPartitionsDataSourceLabel, "partitions.1.size_bytes", "1536"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceTopicPartitionsConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	data "confluent_kafka_topic_partitions" "%s" {
+	  kafka_cluster {
+        id = "%s"
+      }
+
+	  topic_name = "%s"
+	  rest_endpoint = "%s"
+
+	  credentials {
+		key = "%s"
+		secret = "%s"
+	  }
+	}
+	`, confluentCloudBaseUrl, topicResourceLabel, clusterId, topicName, mockServerUrl, kafkaApiKey, kafkaApiSecret)
+}
@@ -45,6 +45,7 @@ func schemaDataSource() *schema.Resource {
 				Description:  "The name of the Schema Registry Subject.",
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			paramContext: subjectContextDataSourceSchema(),
 			paramFormat: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -130,7 +131,7 @@ func schemaDataSourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("error reading Schema: %s", createDescriptiveError(err))
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	subjectName := d.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 	schemaIdentifier := d.Get(paramSchemaIdentifier).(int)
 
 	// Mark resource as new to avoid d.Set("") when getting 404
@@ -0,0 +1,92 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaRegistryContextsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: schemaRegistryContextsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramSchemaRegistryCluster: schemaRegistryClusterBlockDataSourceSchema(),
+			paramRestEndpoint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The REST endpoint of the Schema Registry cluster, for example, `https://psrc-00000.us-central1.gcp.confluent.cloud:443`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+			},
+			paramCredentials: credentialsSchema(),
+			paramContexts: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The contexts of the Schema Registry cluster, for example, `.team-a`. The default context is listed as `.`.",
+			},
+		},
+	}
+}
+
+func schemaRegistryContextsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "Reading Schema Registry Contexts")
+
+	if err := dataSourceCredentialBlockValidationWithOAuth(d, meta.(*Client).isOAuthEnabled); err != nil {
+		return diag.Errorf("error reading Schema Registry Contexts: %s", createDescriptiveError(err))
+	}
+
+	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Schema Registry Contexts: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Schema Registry Contexts: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Schema Registry Contexts: %s", createDescriptiveError(err))
+	}
+	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
+
+	contexts, resp, err := schemaRegistryRestClient.apiClient.ContextsV1Api.ListContexts(schemaRegistryRestClient.apiContext(ctx)).Execute()
+	if err != nil {
+		return diag.Errorf("error reading Schema Registry Contexts: %s", createDescriptiveError(err, resp))
+	}
+	contextsJson, err := json.Marshal(contexts)
+	if err != nil {
+		return diag.Errorf("error reading Schema Registry Contexts: error marshaling %#v to json: %s", contexts, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Schema Registry Contexts: %s", contextsJson))
+
+	if err := d.Set(paramContexts, contexts); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(schemaRegistryRestClient.clusterId)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Schema Registry Contexts %q", d.Id()))
+
+	return nil
+}
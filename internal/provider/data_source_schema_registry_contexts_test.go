@@ -0,0 +1,98 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+const (
+	schemaRegistryContextsDataSourceScenarioName = "confluent_schema_registry_contexts Data Source Lifecycle"
+	readSchemaRegistryContextsPath               = "/contexts"
+)
+
+var fullSchemaRegistryContextsDataSourceLabel = fmt.Sprintf("data.confluent_schema_registry_contexts.%s", testSchemaResourceLabel)
+
+func TestAccDataSourceSchemaRegistryContexts(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockSchemaTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockSchemaTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readContextsResponse, _ := ioutil.ReadFile("../testdata/schema_registry_contexts/read_contexts.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readSchemaRegistryContextsPath)).
+		InScenario(schemaRegistryContextsDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readContextsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSchemaRegistryContextsDataSourceConfig(confluentCloudBaseUrl, mockSchemaTestServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(fullSchemaRegistryContextsDataSourceLabel),
+					resource.TestCheckResourceAttr(fullSchemaRegistryContextsDataSourceLabel, "id", testStreamGovernanceClusterId),
+					resource.TestCheckResourceAttr(fullSchemaRegistryContextsDataSourceLabel, "contexts.#", "3"),
+					resource.TestCheckResourceAttr(fullSchemaRegistryContextsDataSourceLabel, "contexts.0", "."),
+					resource.TestCheckResourceAttr(fullSchemaRegistryContextsDataSourceLabel, "contexts.1", ".team-a"),
+					resource.TestCheckResourceAttr(fullSchemaRegistryContextsDataSourceLabel, "contexts.2", ".team-b"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSchemaRegistryContextsDataSourceConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	data "confluent_schema_registry_contexts" "%s" {
+	  schema_registry_cluster {
+        id = "%s"
+      }
+      rest_endpoint = "%s"
+      credentials {
+        key = "%s"
+        secret = "%s"
+	  }
+	}
+	`, confluentCloudBaseUrl, testSchemaResourceLabel, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret)
+}
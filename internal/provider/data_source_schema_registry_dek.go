@@ -68,6 +68,11 @@ func schemaRegistryDekDataSource() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			paramCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the version of the DEK was created, in RFC3339 format.",
+			},
 			paramHardDelete: {
 				Type:        schema.TypeBool,
 				Computed:    true,
@@ -116,6 +121,7 @@ func schemaRegistryDekDataSourceRead(ctx context.Context, d *schema.ResourceData
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Fetched Schema Registry DEK %q: %s", dekId, dekJson), map[string]interface{}{schemaRegistryDekKey: dekId})
 
+	d.SetId(createDekId(clusterId, dek.GetKekName(), dek.GetSubject(), dek.GetAlgorithm(), dek.GetVersion()))
 	if _, err := setDekAttributes(d, clusterId, dek); err != nil {
 		return diag.FromErr(createDescriptiveError(err))
 	}
@@ -68,6 +68,7 @@ func TestAccDataSourceSchemaRegistryDek(t *testing.T) {
 					resource.TestCheckResourceAttr(dekDataSourceLabel, "subject_name", "ts"),
 					resource.TestCheckResourceAttr(dekDataSourceLabel, "hard_delete", "false"),
 					resource.TestCheckResourceAttr(dekDataSourceLabel, "key_material", ""),
+					resource.TestCheckResourceAttr(dekDataSourceLabel, "created_at", "2024-01-18T01:51:55Z"),
 				),
 			},
 		},
@@ -0,0 +1,175 @@
+// Copyright 2024 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaRegistryDeksDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: schemaRegistryDeksDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramSchemaRegistryCluster: schemaRegistryClusterBlockDataSourceSchema(),
+			paramRestEndpoint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The REST endpoint of the Schema Registry cluster, for example, `https://psrc-00000.us-central1.gcp.confluent.cloud:443`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+			},
+			paramCredentials: credentialsSchema(),
+			paramKekName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramSubjectName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The subject of the DEKs. Defaults to all subjects with DEKs of the KEK.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramAlgorithm: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The algorithm of the DEKs. Defaults to all algorithms.",
+				ValidateFunc: validation.StringInSlice(acceptedDekAlgorithm, false),
+			},
+			paramSchemasFilterDeleted: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to include soft deleted versions.",
+			},
+			paramDeks: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The DEKs of the KEK, one per subject and algorithm.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramSubjectName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramAlgorithm: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramVersions: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "The versions of the DEK, in ascending order.",
+						},
+						paramLatestVersion: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The latest version of the DEK.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaRegistryDeksDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := dataSourceCredentialBlockValidationWithOAuth(d, meta.(*Client).isOAuthEnabled); err != nil {
+		return diag.Errorf("error reading Schema Registry DEKs: %s", createDescriptiveError(err))
+	}
+
+	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Schema Registry DEKs: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Schema Registry DEKs: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Schema Registry DEKs: %s", createDescriptiveError(err))
+	}
+	kekName := d.Get(paramKekName).(string)
+	deleted := d.Get(paramSchemasFilterDeleted).(bool)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading Schema Registry DEKs of KEK %q", kekName))
+
+	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
+
+	subjects := []string{d.Get(paramSubjectName).(string)}
+	if subjects[0] == "" {
+		dekSubjects, resp, err := schemaRegistryRestClient.apiClient.DataEncryptionKeysV1Api.GetDekSubjects(schemaRegistryRestClient.apiContext(ctx), kekName).Deleted(deleted).Execute()
+		if err != nil {
+			return diag.Errorf("error reading Schema Registry DEKs of KEK %q: %s", kekName, createDescriptiveError(err, resp))
+		}
+		sort.Strings(dekSubjects)
+		subjects = dekSubjects
+	}
+	algorithms := acceptedDekAlgorithm
+	if algorithm := d.Get(paramAlgorithm).(string); algorithm != "" {
+		algorithms = []string{algorithm}
+	}
+
+	var deks []map[string]interface{}
+	for _, subject := range subjects {
+		for _, algorithm := range algorithms {
+			versions, resp, err := schemaRegistryRestClient.apiClient.DataEncryptionKeysV1Api.GetDekVersions(schemaRegistryRestClient.apiContext(ctx), kekName, subject).Algorithm(algorithm).Deleted(deleted).Execute()
+			// Subjects don't have DEKs for every algorithm
+			if ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+				continue
+			}
+			if err != nil {
+				return diag.Errorf("error reading Schema Registry DEK versions of KEK %q, subject %q and algorithm %q: %s", kekName, subject, algorithm, createDescriptiveError(err, resp))
+			}
+			if len(versions) == 0 {
+				continue
+			}
+			versionsJson, err := json.Marshal(versions)
+			if err != nil {
+				return diag.Errorf("error reading Schema Registry DEKs: error marshaling %#v to json: %s", versions, createDescriptiveError(err))
+			}
+			tflog.Debug(ctx, fmt.Sprintf("Fetched Schema Registry DEK versions of KEK %q, subject %q and algorithm %q: %s", kekName, subject, algorithm, versionsJson))
+
+			sortedDekVersions := sortedVersions(versions)
+			deks = append(deks, map[string]interface{}{
+				paramSubjectName:   subject,
+				paramAlgorithm:     algorithm,
+				paramVersions:      sortedDekVersions,
+				paramLatestVersion: sortedDekVersions[len(sortedDekVersions)-1],
+			})
+		}
+	}
+
+	if err := d.Set(paramDeks, deks); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterId, kekName))
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Schema Registry DEKs %q", d.Id()))
+
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2024 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+func TestAccDataSourceSchemaRegistryDeks(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readDekSubjectsResponse, _ := ioutil.ReadFile("../testdata/schema_registry_dek/read_dek_subjects.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(createDekUrlPath)).
+		InScenario(deksDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(readDekSubjectsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// Subjects that don't have DEKs for an algorithm return 404
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathMatching("/dek-registry/v1/keks/testkek/deks/.+/versions")).
+		InScenario(deksDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			`{"error_code": 40470, "message": "Key not found"}`,
+			contentTypeJSONHeader,
+			http.StatusNotFound,
+		))
+
+	readDekVersionsResponse, _ := ioutil.ReadFile("../testdata/schema_registry_dek/read_dek_versions.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(dekVersionsUrlPath)).
+		WithQueryParam("algorithm", wiremock.EqualTo("AES256_GCM")).
+		InScenario(deksDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		AtPriority(1).
+		WillReturn(
+			string(readDekVersionsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readOrdersDekVersionsResponse, _ := ioutil.ReadFile("../testdata/schema_registry_dek/read_dek_versions_orders.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/dek-registry/v1/keks/testkek/deks/orders-value/versions")).
+		WithQueryParam("algorithm", wiremock.EqualTo("AES256_SIV")).
+		InScenario(deksDataSourceScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		AtPriority(1).
+		WillReturn(
+			string(readOrdersDekVersionsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckDataSourceDeksDataSourceConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "id", "111/testkek"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.#", "2"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.0.subject_name", "orders-value"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.0.algorithm", "AES256_SIV"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.0.versions.#", "1"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.0.versions.0", "1"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.0.latest_version", "1"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.1.subject_name", "ts"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.1.algorithm", "AES256_GCM"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.1.versions.#", "2"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.1.versions.0", "1"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.1.versions.1", "2"),
+					resource.TestCheckResourceAttr(deksDataSourceLabel, "deks.1.latest_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceDeksDataSourceConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+	  schema_registry_id = "111"
+	  schema_registry_rest_endpoint = "%s" # optionally use SCHEMA_REGISTRY_REST_ENDPOINT env var
+	  schema_registry_api_key       = "11"       # optionally use SCHEMA_REGISTRY_API_KEY env var
+	  schema_registry_api_secret    = "1/1/1/4N/1"    # optionally use SCHEMA_REGISTRY_API_SECRET env var
+	}
+	data "confluent_schema_registry_deks" "deks" {
+	  kek_name = "testkek"
+	}
+	`, mockServerUrl)
+}
@@ -76,6 +76,7 @@ func TestAccDataSourceSchema(t *testing.T) {
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "credentials.0.key", testSchemaRegistryKey),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "credentials.0.secret", testSchemaRegistrySecret),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "subject_name", testSubjectName),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "context", ""),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "format", testFormat),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "schema", testSchemaContent),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "version", strconv.Itoa(testSchemaVersion)),
@@ -92,7 +93,7 @@ func TestAccDataSourceSchema(t *testing.T) {
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "schema_reference.1.name", testSecondSchemaReferenceDisplayName),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "schema_reference.1.subject_name", testSecondSchemaReferenceSubject),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "schema_reference.1.version", strconv.Itoa(testSecondSchemaReferenceVersion)),
-					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "%", strconv.Itoa(testNumberOfSchemaRegistrySchemaResourceAttributes)),
+					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "%", strconv.Itoa(testNumberOfSchemaRegistrySchemaDataSourceAttributes)),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "ruleset.#", "1"),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "ruleset.0.%", "3"),
 					resource.TestCheckResourceAttr(fullSchemaDataSourceLabel, "ruleset.0.domain_rules.#", "2"),
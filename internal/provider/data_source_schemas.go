@@ -52,6 +52,13 @@ func schemasDataSource() *schema.Resource {
 							Optional:    true,
 							Description: "The prefix of the Schema Registry Subject.",
 						},
+						paramContext: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "The Schema Registry context to list the schemas of, for example, `.team-a`.",
+							ValidateFunc:     validation.StringDoesNotContainAny(contextSeparator),
+							DiffSuppressFunc: subjectContextDiffSuppressFunc,
+						},
 						paramSchemasFilterLatestOnly: {
 							Type:        schema.TypeBool,
 							Optional:    true,
@@ -78,6 +85,11 @@ func schemasDataSource() *schema.Resource {
 							Computed:    true,
 							Description: "The name of the schema subject.",
 						},
+						paramContext: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Schema Registry context of the schema subject.",
+						},
 						paramFormat: {
 							Type:        schema.TypeString,
 							Computed:    true,
@@ -150,6 +162,8 @@ func schemasDataSourceRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
 	subjectPrefix := d.Get(fmt.Sprintf("%s.0.%s", paramFilter, paramSchemasFilterSubjectPrefix)).(string)
+	// Subjects outside the default context are listed with their qualified names, for example, ":.team-a:orders-value"
+	subjectPrefix = qualifySubjectName(d.Get(fmt.Sprintf("%s.0.%s", paramFilter, paramContext)).(string), subjectPrefix)
 	latestOnly := d.Get(fmt.Sprintf("%s.0.%s", paramFilter, paramSchemasFilterLatestOnly)).(bool)
 	deleted := d.Get(fmt.Sprintf("%s.0.%s", paramFilter, paramSchemasFilterDeleted)).(bool)
 
@@ -159,8 +173,10 @@ func schemasDataSourceRead(ctx context.Context, d *schema.ResourceData, meta int
 	}
 	result := make([]map[string]interface{}, len(schemas))
 	for i, srSchema := range schemas {
+		subjectContext, _ := splitQualifiedSubjectName(srSchema.GetSubject())
 		result[i] = map[string]interface{}{
 			paramSubjectName:      srSchema.GetSubject(),
+			paramContext:          subjectContext,
 			paramFormat:           srSchema.GetSchemaType(),
 			paramSchema:           srSchema.GetSchema(),
 			paramVersion:          srSchema.GetVersion(),
@@ -75,6 +75,7 @@ func TestAccDataSourceSchemas(t *testing.T) {
 					resource.TestCheckResourceAttr(fullSchemasDataSourceLabel, "schemas.0.version", "1"),
 					resource.TestCheckResourceAttr(fullSchemasDataSourceLabel, "schemas.0.format", "PROTOBUF"),
 					resource.TestCheckResourceAttr(fullSchemasDataSourceLabel, "schemas.0.subject_name", "some_record"),
+					resource.TestCheckResourceAttr(fullSchemasDataSourceLabel, "schemas.0.context", ""),
 					resource.TestCheckResourceAttr(fullSchemasDataSourceLabel, "schemas.0.schema_identifier", "100001"),
 					resource.TestCheckResourceAttr(fullSchemasDataSourceLabel, "schemas.0.schema_reference.#", "0"),
 					resource.TestCheckResourceAttr(fullSchemasDataSourceLabel, "schemas.0.schema", strings.TrimLeft(testSchemasSomeRecordV1, "\n")),
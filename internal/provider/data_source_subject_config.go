@@ -46,6 +46,7 @@ func subjectConfigDataSource() *schema.Resource {
 				Description:  "The name of the Schema Registry Subject.",
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			paramContext: subjectContextDataSourceSchema(),
 			paramCompatibilityLevel: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -88,7 +89,7 @@ func subjectConfigDataSourceRead(ctx context.Context, d *schema.ResourceData, me
 		return diag.Errorf("error reading Subject Config: %s", createDescriptiveError(err))
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	subjectName := d.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 
 	// Mark resource as new to avoid d.Set("") when getting 404
 	d.MarkNewResource()
@@ -48,6 +48,7 @@ func subjectModeDataSource() *schema.Resource {
 				Description:  "The name of the Schema Registry Subject.",
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+			paramContext: subjectContextDataSourceSchema(),
 			paramMode: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -76,7 +77,7 @@ func subjectModeDataSourceRead(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error reading Subject Mode: %s", createDescriptiveError(err))
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	subjectName := d.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 
 	// Mark resource as new to avoid d.Set("") when getting 404
 	d.MarkNewResource()
@@ -110,7 +111,7 @@ func readSubjectModeDataSourceAndSetAttributes(ctx context.Context, d *schema.Re
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Fetched Subject Mode %q: %s", d.Id(), subjectModeJson), map[string]interface{}{subjectModeLoggingKey: d.Id()})
 
-	if err := d.Set(paramSubjectName, subjectName); err != nil {
+	if err := setSubjectNameAndContext(d, subjectName); err != nil {
 		return nil, err
 	}
 
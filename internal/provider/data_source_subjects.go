@@ -0,0 +1,259 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/sync/errgroup"
+
+	schemaregistryv1 "github.com/confluentinc/ccloud-sdk-go-v2/schema-registry/v1"
+)
+
+// The maximum number of subjects that are loaded at the same time, so that large Schema Registry clusters
+// don't hit the rate limits of Schema Registry
+const subjectsDataSourceMaxConcurrentLoads = 8
+
+func subjectsDataSource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: subjectsDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			paramSchemaRegistryCluster: schemaRegistryClusterBlockDataSourceSchema(),
+			paramRestEndpoint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The REST endpoint of the Schema Registry cluster, for example, `https://psrc-00000.us-central1.gcp.confluent.cloud:443`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+			},
+			paramCredentials: credentialsSchema(),
+			paramFilter: {
+				MaxItems:    1,
+				Optional:    true,
+				Type:        schema.TypeList,
+				Description: "Subject filters.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramSchemasFilterSubjectPrefix: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The prefix of the Schema Registry Subjects.",
+						},
+						paramContext: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Description:      "The Schema Registry context to list the subjects of, for example, `.team-a`.",
+							ValidateFunc:     validation.StringDoesNotContainAny(contextSeparator),
+							DiffSuppressFunc: subjectContextDiffSuppressFunc,
+						},
+						paramSchemasFilterDeleted: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether to return soft deleted subjects.",
+						},
+					},
+				},
+			},
+			paramSubjects: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of subjects.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramSubjectName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the subject.",
+						},
+						paramContext: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Schema Registry context of the subject.",
+						},
+						paramVersions: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "The versions of the subject that aren't soft deleted, in ascending order.",
+						},
+						paramDeletedVersions: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "The soft deleted versions of the subject, in ascending order.",
+						},
+						paramLatestVersion: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The latest version of the subject that isn't soft deleted.",
+						},
+						paramLatestSchemaIdentifier: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Globally unique identifier of the latest schema of the subject.",
+						},
+						paramCompatibilityLevel: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The compatibility level of the subject, which is inherited from the Schema Registry cluster unless it's set for the subject.",
+						},
+						paramMode: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The mode of the subject, which is inherited from the Schema Registry cluster unless it's set for the subject.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func subjectsDataSourceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "Reading Subjects")
+
+	if err := dataSourceCredentialBlockValidationWithOAuth(d, meta.(*Client).isOAuthEnabled); err != nil {
+		return diag.Errorf("error reading Subjects: %s", createDescriptiveError(err))
+	}
+
+	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Subjects: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Subjects: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Subjects: %s", createDescriptiveError(err))
+	}
+	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
+	subjectPrefix := d.Get(fmt.Sprintf("%s.0.%s", paramFilter, paramSchemasFilterSubjectPrefix)).(string)
+	subjectPrefix = qualifySubjectName(d.Get(fmt.Sprintf("%s.0.%s", paramFilter, paramContext)).(string), subjectPrefix)
+	deleted := d.Get(fmt.Sprintf("%s.0.%s", paramFilter, paramSchemasFilterDeleted)).(bool)
+
+	subjectNames, resp, err := schemaRegistryRestClient.apiClient.SubjectsV1Api.List(schemaRegistryRestClient.apiContext(ctx)).SubjectPrefix(subjectPrefix).Deleted(deleted).Execute()
+	if err != nil {
+		return diag.Errorf("error reading Subjects: %s", createDescriptiveError(err, resp))
+	}
+	subjectNamesJson, err := json.Marshal(subjectNames)
+	if err != nil {
+		return diag.Errorf("error reading Subjects: error marshaling %#v to json: %s", subjectNames, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Subjects: %s", subjectNamesJson))
+	sort.Strings(subjectNames)
+
+	// The latest schemas of all subjects are loaded at once instead of one request per subject
+	latestSchemas, resp, err := schemaRegistryRestClient.apiClient.SchemasV1Api.GetSchemas(schemaRegistryRestClient.apiContext(ctx)).SubjectPrefix(subjectPrefix).LatestOnly(true).Execute()
+	if err != nil {
+		return diag.Errorf("error reading Subjects: error loading the latest Schemas: %s", createDescriptiveError(err, resp))
+	}
+	latestSchemasBySubjectName := make(map[string]schemaregistryv1.Schema)
+	for _, latestSchema := range latestSchemas {
+		latestSchemasBySubjectName[latestSchema.GetSubject()] = latestSchema
+	}
+
+	result := make([]map[string]interface{}, len(subjectNames))
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(subjectsDataSourceMaxConcurrentLoads)
+	for i, subjectName := range subjectNames {
+		group.Go(func() error {
+			latestSchema, ok := latestSchemasBySubjectName[subjectName]
+			subject, err := loadSubject(groupCtx, schemaRegistryRestClient, subjectName, latestSchema, ok)
+			if err != nil {
+				return fmt.Errorf("error reading Subject %q: %s", subjectName, createDescriptiveError(err))
+			}
+			result[i] = subject
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set(paramSubjects, result); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+
+	tflog.Debug(ctx, "Finished reading Subjects")
+
+	return nil
+}
+
+// loadSubject loads the versions, the compatibility level and the mode of a subject, whose latest schema has already been loaded.
+// The compatibility level and the mode fall back to the ones of the Schema Registry cluster, like Schema Registry does.
+func loadSubject(ctx context.Context, c *SchemaRegistryRestClient, subjectName string, latestSchema schemaregistryv1.Schema, hasLatestSchema bool) (map[string]interface{}, error) {
+	// Subjects with only soft deleted versions return 404
+	versions, resp, err := c.apiClient.SubjectsV1Api.ListVersions(c.apiContext(ctx), subjectName).Execute()
+	if err != nil && !ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+		return nil, fmt.Errorf("error loading versions: %s", createDescriptiveError(err, resp))
+	}
+	deletedVersions, resp, err := c.apiClient.SubjectsV1Api.ListVersions(c.apiContext(ctx), subjectName).DeletedOnly(true).Execute()
+	if err != nil && !ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+		return nil, fmt.Errorf("error loading soft deleted versions: %s", createDescriptiveError(err, resp))
+	}
+
+	// Subjects with only soft deleted versions have no latest schema
+	var latestVersion, latestSchemaIdentifier int32
+	if len(versions) > 0 && hasLatestSchema {
+		latestVersion = latestSchema.GetVersion()
+		latestSchemaIdentifier = latestSchema.GetId()
+	}
+
+	subjectConfig, resp, err := executeSubjectConfigReadWithDefaultToGlobal(ctx, c, subjectName)
+	if err != nil {
+		return nil, fmt.Errorf("error loading Subject Config: %s", createDescriptiveError(err, resp))
+	}
+	subjectMode, resp, err := c.apiClient.ModesV1Api.GetMode(c.apiContext(ctx), subjectName).DefaultToGlobal(true).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("error loading Subject Mode: %s", createDescriptiveError(err, resp))
+	}
+
+	subjectContext, _ := splitQualifiedSubjectName(subjectName)
+	return map[string]interface{}{
+		paramSubjectName:            subjectName,
+		paramContext:                subjectContext,
+		paramVersions:               sortedVersions(versions),
+		paramDeletedVersions:        sortedVersions(deletedVersions),
+		paramLatestVersion:          latestVersion,
+		paramLatestSchemaIdentifier: latestSchemaIdentifier,
+		paramCompatibilityLevel:     subjectConfig.GetCompatibilityLevel(),
+		paramMode:                   subjectMode.GetMode(),
+	}, nil
+}
+
+func sortedVersions(versions []int32) []int {
+	result := make([]int, len(versions))
+	for i, version := range versions {
+		result[i] = int(version)
+	}
+	sort.Ints(result)
+	return result
+}
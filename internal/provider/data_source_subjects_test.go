@@ -0,0 +1,128 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+const (
+	subjectsDataSourceScenarioName = "confluent_subjects Data Source Lifecycle"
+	readSubjectsPath               = "/subjects"
+)
+
+var (
+	fullSubjectsDataSourceLabel = fmt.Sprintf("data.confluent_subjects.%s", testSchemaResourceLabel)
+	readSubjectVersionsPath     = fmt.Sprintf("/subjects/%s/versions", testSubjectName)
+	readSubjectConfigPath       = fmt.Sprintf("/config/%s", testSubjectName)
+	readSubjectModePath         = fmt.Sprintf("/mode/%s", testSubjectName)
+)
+
+func TestAccDataSourceSubjects(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockSchemaTestServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockSchemaTestServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	stubs := []struct {
+		stub         *wiremock.StubRule
+		responsePath string
+	}{
+		{wiremock.Get(wiremock.URLPathEqualTo(readSubjectsPath)).WithQueryParam("subjectPrefix", wiremock.EqualTo("te")), "../testdata/schema_registry_subjects/read_subjects.json"},
+		{wiremock.Get(wiremock.URLPathEqualTo(readSubjectVersionsPath)), "../testdata/schema_registry_subjects/read_versions.json"},
+		{wiremock.Get(wiremock.URLPathEqualTo(readSubjectVersionsPath)).WithQueryParam("deletedOnly", wiremock.EqualTo("true")).AtPriority(1), "../testdata/schema_registry_subjects/read_deleted_versions.json"},
+		{wiremock.Get(wiremock.URLPathEqualTo(readSchemasPath)).WithQueryParam("subjectPrefix", wiremock.EqualTo("te")).WithQueryParam("latestOnly", wiremock.EqualTo("true")), "../testdata/schema_registry_subjects/read_latest_schemas.json"},
+		{wiremock.Get(wiremock.URLPathEqualTo(readSubjectConfigPath)), "../testdata/subject_compatibility_level/read_created_subject_compatibility_level.json"},
+		{wiremock.Get(wiremock.URLPathEqualTo(readSubjectModePath)), "../testdata/subject_mode/read_created_subject_mode.json"},
+	}
+	for _, s := range stubs {
+		response, _ := ioutil.ReadFile(s.responsePath)
+		_ = wiremockClient.StubFor(s.stub.
+			InScenario(subjectsDataSourceScenarioName).
+			WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+			WillReturn(
+				string(response),
+				contentTypeJSONHeader,
+				http.StatusOK,
+			))
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSubjectsDataSourceConfig(confluentCloudBaseUrl, mockSchemaTestServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(fullSubjectsDataSourceLabel),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.#", "1"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.%", "8"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.subject_name", testSubjectName),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.context", ""),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.versions.#", "3"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.versions.0", "6"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.versions.2", "8"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.deleted_versions.#", "2"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.deleted_versions.0", "1"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.latest_version", strconv.Itoa(testSchemaVersion)),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.latest_schema_identifier", strconv.Itoa(testSchemaIdentifier)),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.compatibility_level", "FULL"),
+					resource.TestCheckResourceAttr(fullSubjectsDataSourceLabel, "subjects.0.mode", "READWRITE"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSubjectsDataSourceConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+      endpoint = "%s"
+    }
+	data "confluent_subjects" "%s" {
+	  schema_registry_cluster {
+        id = "%s"
+      }
+      rest_endpoint = "%s"
+      credentials {
+        key = "%s"
+        secret = "%s"
+	  }
+	  filter {
+		subject_prefix = "te"
+	  }
+	}
+	`, confluentCloudBaseUrl, testSchemaResourceLabel, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret)
+}
@@ -15,13 +15,46 @@ import (
 	tableflowv1 "github.com/confluentinc/ccloud-sdk-go-v2/tableflow/v1"
 )
 
+// providerClusterMetadata holds the API key, API secret and REST endpoint of a single cluster (or Flink environment)
+// set in one of the kafka_clusters, schema_registry_clusters or flink_environments blocks of the provider block.
+type providerClusterMetadata struct {
+	apiKey       string
+	apiSecret    string
+	restEndpoint string
+}
+
+// fillFromProviderClusterMetadata fills in the REST endpoint and the API key pair that aren't set with the ones
+// from the provider block entry of the cluster, if any. The returned flag is true only when both the REST endpoint
+// and the API key pair (unless OAuth is used) come from the provider block, just like with the provider level
+// metadata, so that neither is saved in TF state.
+func fillFromProviderClusterMetadata(clusters map[string]providerClusterMetadata, id, restEndpoint, apiKey, apiSecret string, isMetadataSetInProviderBlock bool, token *OAuthToken) (string, string, string, bool) {
+	cluster, ok := clusters[id]
+	if !ok || isMetadataSetInProviderBlock {
+		return restEndpoint, apiKey, apiSecret, isMetadataSetInProviderBlock
+	}
+	isRestEndpointFromProviderBlock := restEndpoint == "" && cluster.restEndpoint != ""
+	if isRestEndpointFromProviderBlock {
+		restEndpoint = cluster.restEndpoint
+	}
+	areCredentialsFromProviderBlock := token != nil
+	if apiKey == "" && token == nil && cluster.apiKey != "" {
+		apiKey, apiSecret = cluster.apiKey, cluster.apiSecret
+		areCredentialsFromProviderBlock = true
+	}
+	return restEndpoint, apiKey, apiSecret, isRestEndpointFromProviderBlock && areCredentialsFromProviderBlock
+}
+
 type FlinkRestClientFactory struct {
 	ctx        context.Context
 	userAgent  string
 	maxRetries *int
+	// environments is keyed by Environment ID
+	environments map[string]providerClusterMetadata
 }
 
 func (f FlinkRestClientFactory) CreateFlinkRestClient(restEndpoint, organizationId, environmentId, computePoolId, principalId, flinkApiKey, flinkApiSecret string, isMetadataSetInProviderBlock bool, token *OAuthToken) *FlinkRestClient {
+	restEndpoint, flinkApiKey, flinkApiSecret, isMetadataSetInProviderBlock = fillFromProviderClusterMetadata(f.environments, environmentId, restEndpoint, flinkApiKey, flinkApiSecret, isMetadataSetInProviderBlock, token)
+
 	var opts []RetryableClientFactoryOption = []RetryableClientFactoryOption{}
 	config := flinkgatewayv1.NewConfiguration()
 
@@ -57,9 +90,13 @@ type SchemaRegistryRestClientFactory struct {
 	ctx        context.Context
 	userAgent  string
 	maxRetries *int
+	// clusters is keyed by Schema Registry cluster ID
+	clusters map[string]providerClusterMetadata
 }
 
 func (f SchemaRegistryRestClientFactory) CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret string, isMetadataSetInProviderBlock bool, token *OAuthToken) *SchemaRegistryRestClient {
+	restEndpoint, clusterApiKey, clusterApiSecret, isMetadataSetInProviderBlock = fillFromProviderClusterMetadata(f.clusters, clusterId, restEndpoint, clusterApiKey, clusterApiSecret, isMetadataSetInProviderBlock, token)
+
 	var opts []RetryableClientFactoryOption = []RetryableClientFactoryOption{}
 
 	// Setup SR API Client
@@ -123,9 +160,13 @@ type KafkaRestClientFactory struct {
 	ctx        context.Context
 	userAgent  string
 	maxRetries *int
+	// clusters is keyed by Kafka cluster ID
+	clusters map[string]providerClusterMetadata
 }
 
 func (f KafkaRestClientFactory) CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret string, isClusterIdSetInProviderBlock, isMetadataSetInProviderBlock bool, token *OAuthToken) *KafkaRestClient {
+	restEndpoint, clusterApiKey, clusterApiSecret, isMetadataSetInProviderBlock = fillFromProviderClusterMetadata(f.clusters, clusterId, restEndpoint, clusterApiKey, clusterApiSecret, isMetadataSetInProviderBlock, token)
+
 	var opts []RetryableClientFactoryOption = []RetryableClientFactoryOption{}
 	config := kafkarestv3.NewConfiguration()
 
@@ -503,3 +503,116 @@ func TestCustomErrorHandlerStatusCode600(t *testing.T) {
 		t.Errorf("expected status 600, got %d", gotResp.StatusCode)
 	}
 }
+
+// ---------------------------------------------------------------------------
+// KafkaRestClientFactory with kafka_clusters provider blocks
+// ---------------------------------------------------------------------------
+
+func TestCreateKafkaRestClientWithProviderClusterMetadata(t *testing.T) {
+	factory := KafkaRestClientFactory{
+		ctx: context.Background(),
+		clusters: map[string]providerClusterMetadata{
+			"lkc-1": {apiKey: "key-1", apiSecret: "secret-1", restEndpoint: "https://lkc-1.confluent.cloud:443"},
+			"lkc-2": {restEndpoint: "https://lkc-2.confluent.cloud:443"},
+		},
+	}
+
+	tests := []struct {
+		name                             string
+		clusterId                        string
+		restEndpoint                     string
+		apiKey                           string
+		apiSecret                        string
+		isMetadataSetInProviderBlock     bool
+		token                            *OAuthToken
+		wantRestEndpoint                 string
+		wantApiKey                       string
+		wantApiSecret                    string
+		wantIsMetadataSetInProviderBlock bool
+	}{
+		{
+			name:                             "fills in everything from the provider block",
+			clusterId:                        "lkc-1",
+			wantRestEndpoint:                 "https://lkc-1.confluent.cloud:443",
+			wantApiKey:                       "key-1",
+			wantApiSecret:                    "secret-1",
+			wantIsMetadataSetInProviderBlock: true,
+		},
+		{
+			name:                             "resource attributes take precedence",
+			clusterId:                        "lkc-2",
+			restEndpoint:                     "https://resource.confluent.cloud:443",
+			apiKey:                           "resource-key",
+			apiSecret:                        "resource-secret",
+			wantRestEndpoint:                 "https://resource.confluent.cloud:443",
+			wantApiKey:                       "resource-key",
+			wantApiSecret:                    "resource-secret",
+			wantIsMetadataSetInProviderBlock: false,
+		},
+		{
+			name:                             "fills in the rest endpoint only",
+			clusterId:                        "lkc-2",
+			apiKey:                           "resource-key",
+			apiSecret:                        "resource-secret",
+			wantRestEndpoint:                 "https://lkc-2.confluent.cloud:443",
+			wantApiKey:                       "resource-key",
+			wantApiSecret:                    "resource-secret",
+			wantIsMetadataSetInProviderBlock: false,
+		},
+		{
+			name:                             "fills in the API key pair only",
+			clusterId:                        "lkc-1",
+			restEndpoint:                     "https://resource.confluent.cloud:443",
+			wantRestEndpoint:                 "https://resource.confluent.cloud:443",
+			wantApiKey:                       "key-1",
+			wantApiSecret:                    "secret-1",
+			wantIsMetadataSetInProviderBlock: false,
+		},
+		{
+			name:                             "doesn't fill in the API key pair with OAuth",
+			clusterId:                        "lkc-1",
+			token:                            &OAuthToken{IdentityPoolId: "pool-1"},
+			wantRestEndpoint:                 "https://lkc-1.confluent.cloud:443",
+			wantIsMetadataSetInProviderBlock: true,
+		},
+		{
+			name:                             "provider level metadata takes precedence",
+			clusterId:                        "lkc-1",
+			restEndpoint:                     "https://provider.confluent.cloud:443",
+			apiKey:                           "provider-key",
+			apiSecret:                        "provider-secret",
+			isMetadataSetInProviderBlock:     true,
+			wantRestEndpoint:                 "https://provider.confluent.cloud:443",
+			wantApiKey:                       "provider-key",
+			wantApiSecret:                    "provider-secret",
+			wantIsMetadataSetInProviderBlock: true,
+		},
+		{
+			name:                             "unknown cluster",
+			clusterId:                        "lkc-3",
+			restEndpoint:                     "https://resource.confluent.cloud:443",
+			apiKey:                           "resource-key",
+			apiSecret:                        "resource-secret",
+			wantRestEndpoint:                 "https://resource.confluent.cloud:443",
+			wantApiKey:                       "resource-key",
+			wantApiSecret:                    "resource-secret",
+			wantIsMetadataSetInProviderBlock: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := factory.CreateKafkaRestClient(tt.restEndpoint, tt.clusterId, tt.apiKey, tt.apiSecret, false, tt.isMetadataSetInProviderBlock, tt.token)
+
+			if c.restEndpoint != tt.wantRestEndpoint {
+				t.Errorf("expected restEndpoint %q, got %q", tt.wantRestEndpoint, c.restEndpoint)
+			}
+			if c.clusterApiKey != tt.wantApiKey || c.clusterApiSecret != tt.wantApiSecret {
+				t.Errorf("expected API key pair (%q, %q), got (%q, %q)", tt.wantApiKey, tt.wantApiSecret, c.clusterApiKey, c.clusterApiSecret)
+			}
+			if c.isMetadataSetInProviderBlock != tt.wantIsMetadataSetInProviderBlock {
+				t.Errorf("expected isMetadataSetInProviderBlock %t, got %t", tt.wantIsMetadataSetInProviderBlock, c.isMetadataSetInProviderBlock)
+			}
+		})
+	}
+}
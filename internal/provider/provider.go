@@ -106,6 +106,8 @@ type Client struct {
 	kafkaApiKey                     string
 	kafkaApiSecret                  string
 	kafkaRestEndpoint               string
+	kafkaPreferredConnectionType    string
+	kafkaClusterCache               *kafkaClusterCache
 	isKafkaClusterIdSet             bool
 	isKafkaMetadataSet              bool
 	schemaRegistryClusterId         string
@@ -205,6 +207,13 @@ func New(version, userAgent string) func() *schema.Provider {
 					DefaultFunc: schema.EnvDefaultFunc("KAFKA_REST_ENDPOINT", ""),
 					Description: "The Kafka Cluster REST Endpoint.",
 				},
+				"kafka_preferred_connection_type": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("KAFKA_PREFERRED_CONNECTION_TYPE", ""),
+					Description: "The preferred connection type of the Kafka Cluster REST Endpoints that are looked up when `rest_endpoint` isn't set, for example, `PRIVATE_LINK`.",
+				},
+				"kafka_clusters": providerClusterMetadataSchema("The Kafka Cluster ID."),
 				"schema_registry_id": {
 					Type:        schema.TypeString,
 					Optional:    true,
@@ -231,6 +240,7 @@ func New(version, userAgent string) func() *schema.Provider {
 					DefaultFunc: schema.EnvDefaultFunc("SCHEMA_REGISTRY_REST_ENDPOINT", ""),
 					Description: "The Schema Registry Cluster REST Endpoint.",
 				},
+				"schema_registry_clusters": providerClusterMetadataSchema("The Schema Registry Cluster ID."),
 				"flink_principal_id": {
 					Type:        schema.TypeString,
 					Optional:    true,
@@ -276,6 +286,7 @@ func New(version, userAgent string) func() *schema.Provider {
 					// Example: "https://flink.us-east-1.aws.confluent.cloud"
 					Description: "The Flink REST Endpoint.",
 				},
+				"flink_environments": providerClusterMetadataSchema("The Flink Environment ID."),
 				"tableflow_api_key": {
 					Type:        schema.TypeString,
 					Optional:    true,
@@ -315,12 +326,14 @@ func New(version, userAgent string) func() *schema.Provider {
 				"confluent_certificate_authority":              certificateAuthorityDataSource(),
 				"confluent_certificate_pool":                   certificatePoolDataSource(),
 				"confluent_cluster_link":                       clusterLinkDataSource(),
+				"confluent_cluster_link_status":                clusterLinkStatusDataSource(),
 				"confluent_connect_artifact":                   connectArtifactDataSource(),
 				"confluent_ip_filter":                          ipFilterDataSource(),
 				"confluent_ip_group":                           ipGroupDataSource(),
 				"confluent_kafka_cluster":                      kafkaDataSource(),
 				"confluent_kafka_clusters":                     kafkaClustersDataSource(),
 				"confluent_kafka_topic":                        kafkaTopicDataSource(),
+				"confluent_kafka_topic_partitions":             kafkaTopicPartitionsDataSource(),
 				"confluent_environment":                        environmentDataSource(),
 				"confluent_environments":                       environmentsDataSource(),
 				"confluent_group_mapping":                      groupMappingDataSource(),
@@ -335,6 +348,7 @@ func New(version, userAgent string) func() *schema.Provider {
 				"confluent_identity_provider":                  identityProviderDataSource(),
 				"confluent_ip_addresses":                       ipAddressesDataSource(),
 				"confluent_kafka_client_quota":                 kafkaClientQuotaDataSource(),
+				"confluent_kafka_client_quotas":                kafkaClientQuotasDataSource(),
 				"confluent_network":                            networkDataSource(),
 				"confluent_access_point":                       accessPointDataSource(),
 				"confluent_endpoint":                           endpointDataSource(),
@@ -353,9 +367,11 @@ func New(version, userAgent string) func() *schema.Provider {
 				"confluent_role_binding":                       roleBindingDataSource(),
 				"confluent_schema":                             schemaDataSource(),
 				"confluent_schemas":                            schemasDataSource(),
+				"confluent_subjects":                           subjectsDataSource(),
 				"confluent_users":                              usersDataSource(),
 				"confluent_service_account":                    serviceAccountDataSource(),
 				"confluent_schema_registry_cluster":            schemaRegistryClusterDataSource(),
+				"confluent_schema_registry_contexts":           schemaRegistryContextsDataSource(),
 				"confluent_schema_registry_clusters":           schemaRegistryClustersDataSource(),
 				"confluent_subject_mode":                       subjectModeDataSource(),
 				"confluent_subject_config":                     subjectConfigDataSource(),
@@ -374,6 +390,7 @@ func New(version, userAgent string) func() *schema.Provider {
 				"confluent_rtce_topic":                         rtceTopicDataSource(),
 				"confluent_schema_registry_kek":                schemaRegistryKekDataSource(),
 				"confluent_schema_registry_dek":                schemaRegistryDekDataSource(),
+				"confluent_schema_registry_deks":               schemaRegistryDeksDataSource(),
 				// cli-tfgen:tf-datasources
 			},
 			ResourcesMap: map[string]*schema.Resource{
@@ -383,6 +400,7 @@ func New(version, userAgent string) func() *schema.Provider {
 				"confluent_certificate_authority":              certificateAuthorityResource(),
 				"confluent_certificate_pool":                   certificatePoolResource(),
 				"confluent_cluster_link":                       clusterLinkResource(),
+				"confluent_cluster_link_failover":              clusterLinkFailoverResource(),
 				"confluent_connect_artifact":                   connectArtifactResource(),
 				"confluent_ip_group":                           ipGroupResource(),
 				"confluent_ip_filter":                          ipFilterResource(),
@@ -406,6 +424,8 @@ func New(version, userAgent string) func() *schema.Provider {
 				"confluent_service_account":                    serviceAccountResource(),
 				"confluent_kafka_topic":                        kafkaTopicResource(),
 				"confluent_kafka_mirror_topic":                 kafkaMirrorTopicResource(),
+				"confluent_kafka_mirror_topics":                kafkaMirrorTopicsResource(),
+				"confluent_kafka_records":                      kafkaRecordsResource(),
 				"confluent_kafka_acl":                          kafkaAclResource(),
 				"confluent_network":                            networkResource(),
 				"confluent_access_point":                       accessPointResource(),
@@ -422,11 +442,13 @@ func New(version, userAgent string) func() *schema.Provider {
 				"confluent_provider_integration_authorization": providerIntegrationAuthorizationResource(),
 				"confluent_role_binding":                       roleBindingResource(),
 				"confluent_schema":                             schemaResource(),
+				"confluent_schema_bundle":                      schemaBundleResource(),
 				"confluent_schema_exporter":                    schemaExporterResource(),
 				"confluent_subject_mode":                       subjectModeResource(),
 				"confluent_subject_config":                     subjectConfigResource(),
 				"confluent_schema_registry_cluster_mode":       schemaRegistryClusterModeResource(),
 				"confluent_schema_registry_cluster_config":     schemaRegistryClusterConfigResource(),
+				"confluent_schema_registry_migration":          schemaRegistryMigrationResource(),
 				"confluent_transit_gateway_attachment":         transitGatewayAttachmentResource(),
 				"confluent_invitation":                         invitationResource(),
 				"confluent_network_link_endpoint":              networkLinkEndpointResource(),
@@ -535,6 +557,7 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	kafkaApiKey := d.Get("kafka_api_key").(string)
 	kafkaApiSecret := d.Get("kafka_api_secret").(string)
 	kafkaRestEndpoint := d.Get("kafka_rest_endpoint").(string)
+	kafkaPreferredConnectionType := d.Get("kafka_preferred_connection_type").(string)
 	schemaRegistryClusterId := d.Get("schema_registry_id").(string)
 	schemaRegistryApiKey := d.Get("schema_registry_api_key").(string)
 	schemaRegistryApiSecret := d.Get("schema_registry_api_secret").(string)
@@ -666,6 +689,20 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	stsV1Cfg.UserAgent = userAgent
 	// cli-tfgen:tf-client-useragent
 
+	_, isOAuthBlockSet := d.GetOk(paramOAuthBlockName)
+	kafkaClusters, diags := extractProviderClusterMetadata(d, "kafka_clusters", isOAuthBlockSet)
+	if diags != nil {
+		return nil, diags
+	}
+	schemaRegistryClusters, diags := extractProviderClusterMetadata(d, "schema_registry_clusters", isOAuthBlockSet)
+	if diags != nil {
+		return nil, diags
+	}
+	flinkEnvironments, diags := extractProviderClusterMetadata(d, "flink_environments", isOAuthBlockSet)
+	if diags != nil {
+		return nil, diags
+	}
+
 	var catalogRestClientFactory *CatalogRestClientFactory
 	var flinkRestClientFactory *FlinkRestClientFactory
 	var kafkaRestClientFactory *KafkaRestClientFactory
@@ -673,9 +710,9 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	var tableflowRestClientFactory *TableflowRestClientFactory
 
 	catalogRestClientFactory = &CatalogRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries}
-	flinkRestClientFactory = &FlinkRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries}
-	kafkaRestClientFactory = &KafkaRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries}
-	schemaRegistryRestClientFactory = &SchemaRegistryRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries}
+	flinkRestClientFactory = &FlinkRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries, environments: flinkEnvironments}
+	kafkaRestClientFactory = &KafkaRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries, clusters: kafkaClusters}
+	schemaRegistryRestClientFactory = &SchemaRegistryRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries, clusters: schemaRegistryClusters}
 	tableflowRestClientFactory = &TableflowRestClientFactory{ctx: ctx, userAgent: userAgent, maxRetries: &maxRetries, endpoint: endpoint}
 
 	apiKeysV2Cfg.HTTPClient = NewRetryableClientFactory(ctx, WithMaxRetries(maxRetries)).CreateRetryableClient()
@@ -790,29 +827,31 @@ func providerConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		ssoV2Client:                     ssov2.NewAPIClient(ssoV2Cfg),
 		stsV1Client:                     secureTokenServiceClient,
 		// cli-tfgen:tf-client-literal
-		userAgent:                  userAgent,
-		catalogRestEndpoint:        catalogRestEndpoint,
-		cloudApiKey:                cloudApiKey,
-		cloudApiSecret:             cloudApiSecret,
-		kafkaClusterId:             kafkaClusterId,
-		kafkaApiKey:                kafkaApiKey,
-		kafkaApiSecret:             kafkaApiSecret,
-		kafkaRestEndpoint:          kafkaRestEndpoint,
-		schemaRegistryClusterId:    schemaRegistryClusterId,
-		schemaRegistryApiKey:       schemaRegistryApiKey,
-		schemaRegistryApiSecret:    schemaRegistryApiSecret,
-		schemaRegistryRestEndpoint: schemaRegistryRestEndpoint,
-		flinkPrincipalId:           flinkPrincipalId,
-		flinkOrganizationId:        flinkOrganizationId,
-		flinkEnvironmentId:         flinkEnvironmentId,
-		flinkComputePoolId:         flinkComputePoolId,
-		flinkApiKey:                flinkApiKey,
-		flinkApiSecret:             flinkApiSecret,
-		flinkRestEndpoint:          flinkRestEndpoint,
-		tableflowApiKey:            tableflowApiKey,
-		tableflowApiSecret:         tableflowApiSecret,
-		oauthToken:                 externalOAuthToken,
-		stsToken:                   stsOAuthToken,
+		userAgent:                    userAgent,
+		catalogRestEndpoint:          catalogRestEndpoint,
+		cloudApiKey:                  cloudApiKey,
+		cloudApiSecret:               cloudApiSecret,
+		kafkaClusterId:               kafkaClusterId,
+		kafkaApiKey:                  kafkaApiKey,
+		kafkaApiSecret:               kafkaApiSecret,
+		kafkaRestEndpoint:            kafkaRestEndpoint,
+		kafkaPreferredConnectionType: kafkaPreferredConnectionType,
+		kafkaClusterCache:            newKafkaClusterCache(),
+		schemaRegistryClusterId:      schemaRegistryClusterId,
+		schemaRegistryApiKey:         schemaRegistryApiKey,
+		schemaRegistryApiSecret:      schemaRegistryApiSecret,
+		schemaRegistryRestEndpoint:   schemaRegistryRestEndpoint,
+		flinkPrincipalId:             flinkPrincipalId,
+		flinkOrganizationId:          flinkOrganizationId,
+		flinkEnvironmentId:           flinkEnvironmentId,
+		flinkComputePoolId:           flinkComputePoolId,
+		flinkApiKey:                  flinkApiKey,
+		flinkApiSecret:               flinkApiSecret,
+		flinkRestEndpoint:            flinkRestEndpoint,
+		tableflowApiKey:              tableflowApiKey,
+		tableflowApiSecret:           tableflowApiSecret,
+		oauthToken:                   externalOAuthToken,
+		stsToken:                     stsOAuthToken,
 
 		// For simplicity, treat 3 (for Kafka), 4 (for SR), 4 (for catalog), 7 (for Flink), and 2 (for Tableflow) variables as a "single" one
 		isKafkaMetadataSet:           resourceMetadataFlags.isKafkaMetadataSet,
@@ -936,6 +975,67 @@ func providerOAuthSchema() *schema.Schema {
 	}
 }
 
+// providerClusterMetadataSchema returns the schema of a repeated provider block that sets the API key pair
+// and the REST endpoint of a single cluster, so that one provider block can manage resources across clusters.
+func providerClusterMetadataSchema(idDescription string) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramId: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  idDescription,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				paramApiKey: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The API Key.",
+				},
+				paramApiSecret: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Sensitive:   true,
+					Description: "The API Secret.",
+				},
+				paramRestEndpoint: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The REST Endpoint.",
+				},
+			},
+		},
+	}
+}
+
+// extractProviderClusterMetadata returns the entries of the blockName repeated provider block keyed by their IDs.
+func extractProviderClusterMetadata(d *schema.ResourceData, blockName string, isOAuthEnabled bool) (map[string]providerClusterMetadata, diag.Diagnostics) {
+	clusters := make(map[string]providerClusterMetadata)
+	for _, block := range d.Get(blockName).([]interface{}) {
+		blockMap := block.(map[string]interface{})
+		id := blockMap[paramId].(string)
+		if _, ok := clusters[id]; ok {
+			return nil, diag.Errorf("%q is set in more than one %s block in the provider block", id, blockName)
+		}
+		cluster := providerClusterMetadata{
+			apiKey:       blockMap[paramApiKey].(string),
+			apiSecret:    blockMap[paramApiSecret].(string),
+			restEndpoint: blockMap[paramRestEndpoint].(string),
+		}
+		if (cluster.apiKey == "") != (cluster.apiSecret == "") {
+			return nil, diag.Errorf("both (api_key, api_secret) attributes should be set or unset for %q in %s block in the provider block", id, blockName)
+		}
+		if isOAuthEnabled && cluster.apiKey != "" {
+			return nil, diag.Errorf("(api_key, api_secret) attributes of %s block should not be set in the provider block when oauth block is present", blockName)
+		}
+		clusters[id] = cluster
+	}
+	return clusters, nil
+}
+
 func validateOAuthAndProviderAPIKeysCoexist(
 	cloudApiKey, cloudApiSecret,
 	kafkaApiKey, kafkaApiSecret,
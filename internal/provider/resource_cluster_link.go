@@ -102,8 +102,13 @@ func clusterLinkResource() *schema.Resource {
 				Computed:         true,
 				Description:      "The custom cluster link settings to set (e.g., `\"acl.sync.ms\" = \"5100\"`).",
 				ValidateDiagFunc: clusterLinkSettingsKeysValidate,
+				DiffSuppressFunc: suppressEquivalentClusterLinkFilterSettings,
 			},
+			paramMirrorTopicFilter:   clusterLinkNameFilterSchema("The filters that select the topics to create mirror topics for automatically, serialized into the `auto.create.mirror.topics.filters` cluster link setting."),
+			paramConsumerGroupFilter: clusterLinkNameFilterSchema("The filters that select the consumer groups to sync offsets for, serialized into the `consumer.offset.group.filters` cluster link setting."),
+			paramAclFilter:           clusterLinkAclFilterSchema(),
 		},
+		CustomizeDiff: clusterLinkFiltersCustomizeDiff,
 	}
 }
 
@@ -324,6 +329,9 @@ func setClusterLinkAttributes(ctx context.Context, d *schema.ResourceData, c *Ka
 	if err != nil {
 		return nil, err
 	}
+	if err := setClusterLinkFilterAttributes(d, configs); err != nil {
+		return nil, err
+	}
 	if err := d.Set(paramConfigs, configs); err != nil {
 		return nil, err
 	}
@@ -344,8 +352,8 @@ func buildOptionalCredentialBlock(credentialKey, credentialSecret string) []inte
 }
 
 func clusterLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramSourceKafkaCluster, paramSourceKafkaCredentials, paramDestinationKafkaCluster, paramDestinationKafkaCredentials, paramLocalKafkaCluster, paramLocalKafkaCredentials, paramRemoteKafkaCluster, paramRemoteKafkaCredentials, paramConfigs) {
-		return diag.Errorf("error updating Cluster Link %q: only %q, %q, %q, %q and %q attributes can be updated for Cluster Link", d.Id(), paramSourceKafkaCredentials, paramDestinationKafkaCredentials, paramLocalKafkaCredentials, paramRemoteKafkaCredentials, paramConfigs)
+	if d.HasChangesExcept(paramSourceKafkaCluster, paramSourceKafkaCredentials, paramDestinationKafkaCluster, paramDestinationKafkaCredentials, paramLocalKafkaCluster, paramLocalKafkaCredentials, paramRemoteKafkaCluster, paramRemoteKafkaCredentials, paramConfigs, paramMirrorTopicFilter, paramConsumerGroupFilter, paramAclFilter) {
+		return diag.Errorf("error updating Cluster Link %q: only %q, %q, %q, %q, %q, %q, %q and %q attributes can be updated for Cluster Link", d.Id(), paramSourceKafkaCredentials, paramDestinationKafkaCredentials, paramLocalKafkaCredentials, paramRemoteKafkaCredentials, paramConfigs, paramMirrorTopicFilter, paramConsumerGroupFilter, paramAclFilter)
 	}
 
 	if d.HasChanges(paramSourceKafkaCredentials) || d.HasChanges(paramDestinationKafkaCredentials) ||
@@ -379,7 +387,7 @@ func clusterLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		SleepIfNotTestMode(kafkaRestAPIWaitAfterCreate, meta.(*Client).isAcceptanceTestMode, meta.(*Client).isLiveProductionTestMode)
 	}
 
-	if d.HasChange(paramConfigs) {
+	if d.HasChanges(paramConfigs, paramMirrorTopicFilter, paramConsumerGroupFilter, paramAclFilter) {
 		// TF Provider allows the following operations for editable cluster link settings under 'config' block:
 		// 1. Adding new key value pair, for example, "retention.ms" = "600000"
 		// 2. Update a value for existing key value pair, for example, "retention.ms" = "600000" -> "retention.ms" = "600001"
@@ -391,39 +399,67 @@ func clusterLinkUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		// * 'new' cluster link settings -- all cluster link settings from TF configuration _after_ changes
 		oldClusterSettingsMap, newClusterSettingsMap := extractOldAndNewSettings(d)
 
-		// Verify that no cluster link settings were removed (reset to its default value) in TF configuration which is an unsupported operation at the moment
-		for oldSettingName := range oldClusterSettingsMap {
-			if _, ok := newClusterSettingsMap[oldSettingName]; !ok {
-				return diag.Errorf("error updating Cluster Link %q: reset to cluster link setting's default value operation (in other words, removing cluster link settings from %q block) "+
-					"is not supported at the moment. "+
-					"Instead, find its default value at %s and set its current value to the default value.", d.Id(), paramConfigs, docsClusterLinkConfigUrl)
+		// Changes of 'config' block and filter blocks are sent in a single request
+		var updateConfigRequestData []kafkarestv3.AlterConfigBatchRequestDataData
+		if d.HasChange(paramConfigs) {
+			// Verify that no cluster link settings were removed (reset to its default value) in TF configuration which is an unsupported operation at the moment
+			for oldSettingName := range oldClusterSettingsMap {
+				if _, ok := newClusterSettingsMap[oldSettingName]; !ok && !isClusterLinkSettingManagedByFilterBlock(d, oldSettingName) {
+					return diag.Errorf("error updating Cluster Link %q: reset to cluster link setting's default value operation (in other words, removing cluster link settings from %q block) "+
+						"is not supported at the moment. "+
+						"Instead, find its default value at %s and set its current value to the default value.", d.Id(), paramConfigs, docsClusterLinkConfigUrl)
+				}
 			}
+			_, newSettingsMapAny := d.GetChange(paramConfigs)
+			updateConfigRequestData = extractClusterLinkConfigsAlterConfigBatchRequestData(newSettingsMapAny.(map[string]interface{}))
 		}
-
-		// Construct a request for Kafka REST API
-		_, newSettingsMapAny := d.GetChange(paramConfigs)
-		updateConfigRequest := kafkarestv3.AlterConfigBatchRequestData{
-			Data: extractClusterLinkConfigsAlterConfigBatchRequestData(newSettingsMapAny.(map[string]interface{})),
-		}
-		kafkaRestClient, err := createKafkaRestClientForClusterLink(d, meta)
-		if err != nil {
-			return diag.Errorf("error updating Cluster Link: %s", createDescriptiveError(err))
-		}
-		linkName := d.Get(paramLinkName).(string)
-		updateConfigRequestJson, err := json.Marshal(updateConfigRequest)
-		if err != nil {
-			return diag.Errorf("error updating Cluster Link: error marshaling %#v to json: %s", updateConfigRequest, createDescriptiveError(err))
+		// 'config' block is Optional and Computed, so only the cluster link settings written in TF configuration are checked
+		configs := extractClusterLinkSettingsFromRawConfig(d.GetRawConfig())
+		for blockName, settingName := range clusterLinkFilterBlockSettings {
+			if !d.HasChange(blockName) {
+				continue
+			}
+			// The value from 'config' block wins when a filter block is replaced with its setting in 'config' block
+			if _, isSetInConfigs := configs[settingName]; isSetInConfigs {
+				continue
+			}
+			// Removing all blocks of a filter type is serialized into an empty list of filters
+			// since resetting cluster link settings to their default values is not supported
+			settingValue, err := serializeClusterLinkFilters(blockName, d.Get(blockName).([]interface{}))
+			if err != nil {
+				return diag.Errorf("error updating Cluster Link %q: invalid %q block: %s", d.Id(), blockName, createDescriptiveError(err))
+			}
+			updateConfigRequestData = append(updateConfigRequestData, kafkarestv3.AlterConfigBatchRequestDataData{
+				Name:  settingName,
+				Value: *kafkarestv3.NewNullableString(&settingValue),
+			})
 		}
-		tflog.Debug(ctx, fmt.Sprintf("Updating Cluster Link %q: %s", d.Id(), updateConfigRequestJson), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
 
-		// Send a request to Kafka REST API
-		resp, err := executeClusterLinkConfigUpdate(ctx, kafkaRestClient, linkName, updateConfigRequest)
-		if err != nil {
-			// For example, Kafka REST API will return Bad Request if new cluster link setting value exceeds the max limit:
-			// 400 Bad Request: Config property 'delete.retention.ms' with value '63113904003' exceeded max limit of 60566400000.
-			return diag.Errorf("error updating Cluster Link Config: %s", createDescriptiveError(err, resp))
+		if len(updateConfigRequestData) > 0 {
+			// Construct a request for Kafka REST API
+			updateConfigRequest := kafkarestv3.AlterConfigBatchRequestData{
+				Data: updateConfigRequestData,
+			}
+			kafkaRestClient, err := createKafkaRestClientForClusterLink(d, meta)
+			if err != nil {
+				return diag.Errorf("error updating Cluster Link: %s", createDescriptiveError(err))
+			}
+			linkName := d.Get(paramLinkName).(string)
+			updateConfigRequestJson, err := json.Marshal(updateConfigRequest)
+			if err != nil {
+				return diag.Errorf("error updating Cluster Link: error marshaling %#v to json: %s", updateConfigRequest, createDescriptiveError(err))
+			}
+			tflog.Debug(ctx, fmt.Sprintf("Updating Cluster Link %q: %s", d.Id(), updateConfigRequestJson), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+
+			// Send a request to Kafka REST API
+			resp, err := executeClusterLinkConfigUpdate(ctx, kafkaRestClient, linkName, updateConfigRequest)
+			if err != nil {
+				// For example, Kafka REST API will return Bad Request if new cluster link setting value exceeds the max limit:
+				// 400 Bad Request: Config property 'delete.retention.ms' with value '63113904003' exceeded max limit of 60566400000.
+				return diag.Errorf("error updating Cluster Link Config: %s", createDescriptiveError(err, resp))
+			}
+			SleepIfNotTestMode(kafkaRestAPIWaitAfterCreate, meta.(*Client).isAcceptanceTestMode, meta.(*Client).isLiveProductionTestMode)
 		}
-		SleepIfNotTestMode(kafkaRestAPIWaitAfterCreate, meta.(*Client).isAcceptanceTestMode, meta.(*Client).isLiveProductionTestMode)
 		tflog.Debug(ctx, fmt.Sprintf("Finished updating Cluster Link %q", d.Id()), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
 	}
 	return clusterLinkRead(ctx, d, meta)
@@ -724,7 +760,15 @@ func createKafkaRestClientForClusterLink(d *schema.ResourceData, meta interface{
 func constructClusterLinkRequest(d *schema.ResourceData, meta interface{}) (kafkarestv3.CreateLinkRequestData, error) {
 	linkMode := d.Get(paramLinkMode).(string)
 	connectionMode := d.Get(paramConnectionMode).(string)
-	clusterLinkSettings := extractClusterLinkConfigsConfigData(d.Get(paramConfigs).(map[string]interface{}))
+	clusterLinkSettingsMap := d.Get(paramConfigs).(map[string]interface{})
+	filterSettings, err := extractClusterLinkFilterSettings(d)
+	if err != nil {
+		return kafkarestv3.CreateLinkRequestData{}, err
+	}
+	for settingName, settingValue := range filterSettings {
+		clusterLinkSettingsMap[settingName] = settingValue
+	}
+	clusterLinkSettings := extractClusterLinkConfigsConfigData(clusterLinkSettingsMap)
 
 	if linkMode == linkModeBidirectional {
 		if connectionMode == connectionModeOutbound {
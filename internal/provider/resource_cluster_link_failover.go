@@ -0,0 +1,462 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	clusterLinkFailoverActionFailover                 = "FAILOVER"
+	clusterLinkFailoverActionPromote                  = "PROMOTE"
+	clusterLinkFailoverActionReverseAndStartMirror    = "REVERSE_AND_START_MIRROR"
+	clusterLinkFailoverActionReverseAndPauseMirror    = "REVERSE_AND_PAUSE_MIRROR"
+	clusterLinkFailoverActionTruncateAndRestoreMirror = "TRUNCATE_AND_RESTORE_MIRROR"
+)
+
+var acceptedClusterLinkFailoverActions = []string{
+	clusterLinkFailoverActionFailover,
+	clusterLinkFailoverActionPromote,
+	clusterLinkFailoverActionReverseAndStartMirror,
+	clusterLinkFailoverActionReverseAndPauseMirror,
+	clusterLinkFailoverActionTruncateAndRestoreMirror,
+}
+
+// clusterLinkFailoverActionSpec describes how an action changes the status of a local mirror topic.
+type clusterLinkFailoverActionSpec struct {
+	// TargetStatus is the status of a local mirror topic once the action has been applied to it
+	TargetStatus string
+	// PendingStatuses are the statuses a local mirror topic goes through before it reaches TargetStatus
+	PendingStatuses []string
+	// NoOpStatuses are the statuses of a local mirror topic the action wouldn't change, for example,
+	// when a previous partially failed run has already applied the action to it
+	NoOpStatuses []string
+}
+
+// Reversing a mirror topic makes the local topic writable, so it ends up stopped like a failed over topic,
+// while the remote topic starts (or pauses) mirroring from it. Unlike failing over, promoting or reversing
+// a stopped mirror topic is not a no-op, so Kafka REST API decides whether it can be applied.
+var clusterLinkFailoverActionSpecs = map[string]clusterLinkFailoverActionSpec{
+	clusterLinkFailoverActionFailover: {
+		TargetStatus:    stateStopped,
+		PendingStatuses: []string{statePendingStopped},
+		NoOpStatuses:    []string{stateStopped},
+	},
+	clusterLinkFailoverActionPromote: {
+		TargetStatus: stateStopped,
+		// Promoting a mirror topic waits for it to catch up with the source topic first
+		PendingStatuses: []string{statePendingSynchronize, statePendingStopped},
+	},
+	clusterLinkFailoverActionReverseAndStartMirror: {
+		TargetStatus:    stateStopped,
+		PendingStatuses: []string{statePendingStopped},
+	},
+	clusterLinkFailoverActionReverseAndPauseMirror: {
+		TargetStatus:    stateStopped,
+		PendingStatuses: []string{statePendingStopped},
+	},
+	clusterLinkFailoverActionTruncateAndRestoreMirror: {
+		TargetStatus: stateActive,
+		// Truncating and restoring a stopped topic turns it back into a mirror topic in two steps
+		PendingStatuses: []string{statePendingSetupForRestore, statePendingRestore},
+		NoOpStatuses:    []string{stateActive},
+	},
+}
+
+// Kafka REST API client doesn't support the failback actions, so their paths are defined here.
+var clusterLinkFailbackActionPaths = map[string]string{
+	clusterLinkFailoverActionReverseAndStartMirror:    "reverse-and-start-mirror",
+	clusterLinkFailoverActionReverseAndPauseMirror:    "reverse-and-pause-mirror",
+	clusterLinkFailoverActionTruncateAndRestoreMirror: "truncate-and-restore-mirror",
+}
+
+func clusterLinkFailoverResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: clusterLinkFailoverCreate,
+		ReadContext:   clusterLinkFailoverRead,
+		UpdateContext: clusterLinkFailoverUpdate,
+		DeleteContext: clusterLinkFailoverDelete,
+		CustomizeDiff: clusterLinkFailoverCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: mirrorTopicKafkaClusterBlockSchema(),
+			paramClusterLink:  clusterLinkBlockSchema(),
+			paramAction: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The action to apply to the mirror topics of the Cluster Link.",
+				ValidateFunc: validation.StringInSlice(acceptedClusterLinkFailoverActions, false),
+			},
+			paramMirrorTopicNames: {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   "The names of the mirror topics to apply the action to. Defaults to all mirror topics of the Cluster Link.",
+				ConflictsWith: []string{paramMirrorTopicNamePattern},
+			},
+			paramMirrorTopicNamePattern: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "The regular expression that the names of the mirror topics to apply the action to must match.",
+				ValidateFunc:  validation.StringIsValidRegExp,
+				ConflictsWith: []string{paramMirrorTopicNames},
+			},
+			paramMirrorTopics: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The result of the action for each of the selected mirror topics.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramMirrorTopicName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramMirrorStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramErrorCode: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						paramErrorMessage: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func clusterLinkFailoverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(ctx, d, meta)
+	if err != nil {
+		return diag.Errorf("error creating Cluster Link Failover: %s", createDescriptiveError(err))
+	}
+	linkName := extractStringValueFromBlock(d, paramClusterLink, paramLinkName)
+	action := d.Get(paramAction).(string)
+	actionSpec := clusterLinkFailoverActionSpecs[action]
+	clusterLinkFailoverId := createClusterLinkFailoverId(kafkaRestClient.clusterId, linkName, action)
+
+	mirrorTopics, resp, err := kafkaRestClient.apiClient.ClusterLinkingV3Api.ListKafkaMirrorTopicsUnderLink(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId, linkName).Execute()
+	if err != nil {
+		return diag.Errorf("error creating Cluster Link Failover %q: error listing mirror topics: %s", clusterLinkFailoverId, createDescriptiveError(err, resp))
+	}
+	mirrorTopicStatuses := extractMirrorTopicStatuses(mirrorTopics.Data)
+	mirrorTopicNames, err := selectClusterLinkFailoverMirrorTopics(mirrorTopicStatuses, convertToStringSlice(d.Get(paramMirrorTopicNames).(*schema.Set).List()), d.Get(paramMirrorTopicNamePattern).(string))
+	if err != nil {
+		return diag.Errorf("error creating Cluster Link Failover %q: %s", clusterLinkFailoverId, createDescriptiveError(err))
+	}
+
+	results, err := applyClusterLinkFailoverAction(ctx, kafkaRestClient, linkName, action, mirrorTopicNames, mirrorTopicStatuses, actionSpec.NoOpStatuses, meta)
+	if err != nil {
+		return diag.Errorf("error creating Cluster Link Failover %q: %s", clusterLinkFailoverId, createDescriptiveError(err))
+	}
+
+	d.SetId(clusterLinkFailoverId)
+	sortedResults := sortClusterLinkFailoverResults(results)
+	if err := d.Set(paramMirrorTopics, sortedResults); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	if failedMirrorTopicNames := extractFailedClusterLinkFailoverMirrorTopicNames(sortedResults); len(failedMirrorTopicNames) > 0 {
+		// Keep the per-topic results instead of tainting the resource, so that the next plan retries the action
+		// for the failed mirror topics only
+		tflog.Warn(ctx, fmt.Sprintf("%q action of Cluster Link Failover %q failed for %d of %d Kafka Mirror Topics", action, d.Id(), len(failedMirrorTopicNames), len(mirrorTopicNames)), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+		diags := clusterLinkFailoverRead(ctx, d, meta)
+		return append(diags, clusterLinkFailoverPartiallyAppliedWarning(action, failedMirrorTopicNames, len(mirrorTopicNames)))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Cluster Link Failover %q", d.Id()), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+
+	return clusterLinkFailoverRead(ctx, d, meta)
+}
+
+func clusterLinkFailoverPartiallyAppliedWarning(action string, failedMirrorTopicNames []string, totalCount int) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Cluster Link Failover action was applied partially",
+		Detail:   fmt.Sprintf("%q action failed for %d out of %d mirror topics: %s. Check %q attribute for details.\n\nThe results are saved to the Terraform state. Re-run 'terraform apply' to retry the action for the failed mirror topics.", action, len(failedMirrorTopicNames), totalCount, strings.Join(failedMirrorTopicNames, ", "), paramMirrorTopics),
+	}
+}
+
+// clusterLinkFailoverCustomizeDiff plans an update that retries the action for the mirror topics it failed for
+// when a previous 'terraform apply' managed to apply it to only some of them.
+func clusterLinkFailoverCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || diff.HasChanges(paramAction, paramMirrorTopicNames, paramMirrorTopicNamePattern) {
+		return nil
+	}
+	if len(extractFailedClusterLinkFailoverMirrorTopicNames(diff.Get(paramMirrorTopics).([]interface{}))) == 0 {
+		return nil
+	}
+	return diff.SetNewComputed(paramMirrorTopics)
+}
+
+func clusterLinkFailoverRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Cluster Link Failover %q", d.Id()), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+
+	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(ctx, d, meta)
+	if err != nil {
+		return diag.Errorf("error reading Cluster Link Failover %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	linkName := extractStringValueFromBlock(d, paramClusterLink, paramLinkName)
+
+	mirrorTopics, resp, err := kafkaRestClient.apiClient.ClusterLinkingV3Api.ListKafkaMirrorTopicsUnderLink(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId, linkName).Execute()
+	if err != nil {
+		if ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+			// The action has already been applied, so keep its results even if the Cluster Link has been deleted since
+			tflog.Warn(ctx, fmt.Sprintf("Cluster Link %q of Cluster Link Failover %q is not found, keeping the last known mirror topic statuses", linkName, d.Id()), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+			return nil
+		}
+		return diag.Errorf("error reading Cluster Link Failover %q: error listing mirror topics: %s", d.Id(), createDescriptiveError(err, resp))
+	}
+	mirrorTopicStatuses := extractMirrorTopicStatuses(mirrorTopics.Data)
+
+	results := d.Get(paramMirrorTopics).([]interface{})
+	for _, result := range results {
+		result := result.(map[string]interface{})
+		// Mirror topics that have been deleted since keep their last known status
+		if mirrorStatus, ok := mirrorTopicStatuses[result[paramMirrorTopicName].(string)]; ok {
+			result[paramMirrorStatus] = mirrorStatus
+		}
+	}
+	if err := d.Set(paramMirrorTopics, results); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Cluster Link Failover %q", d.Id()), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+
+	return nil
+}
+
+func clusterLinkFailoverUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramKafkaCluster, paramKafkaMirrorTopicCredentials, paramMirrorTopics) {
+		return diag.Errorf("error updating Cluster Link Failover %q: only %q attribute can be updated for Cluster Link Failover", d.Id(), paramKafkaMirrorTopicCredentials)
+	}
+	oldResults, _ := d.GetChange(paramMirrorTopics)
+	failedMirrorTopicNames := extractFailedClusterLinkFailoverMirrorTopicNames(oldResults.([]interface{}))
+	if len(failedMirrorTopicNames) == 0 {
+		return clusterLinkFailoverRead(ctx, d, meta)
+	}
+
+	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(ctx, d, meta)
+	if err != nil {
+		return diag.Errorf("error updating Cluster Link Failover %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	linkName := extractStringValueFromBlock(d, paramClusterLink, paramLinkName)
+	action := d.Get(paramAction).(string)
+	actionSpec := clusterLinkFailoverActionSpecs[action]
+
+	mirrorTopics, resp, err := kafkaRestClient.apiClient.ClusterLinkingV3Api.ListKafkaMirrorTopicsUnderLink(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId, linkName).Execute()
+	if err != nil {
+		return diag.Errorf("error updating Cluster Link Failover %q: error listing mirror topics: %s", d.Id(), createDescriptiveError(err, resp))
+	}
+	mirrorTopicStatuses := extractMirrorTopicStatuses(mirrorTopics.Data)
+
+	tflog.Debug(ctx, fmt.Sprintf("Retrying %q action of Cluster Link Failover %q for Kafka Mirror Topics %v", action, d.Id(), failedMirrorTopicNames), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+	// Failed mirror topics that have reached the target status since, for example, after waiting for them timed out,
+	// are skipped too, so the action is never applied twice to the same mirror topic
+	skippedStatuses := append([]string{actionSpec.TargetStatus}, actionSpec.NoOpStatuses...)
+	retriedResults, err := applyClusterLinkFailoverAction(ctx, kafkaRestClient, linkName, action, failedMirrorTopicNames, mirrorTopicStatuses, skippedStatuses, meta)
+	if err != nil {
+		return diag.Errorf("error updating Cluster Link Failover %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	results := make(map[string]map[string]interface{})
+	for _, result := range oldResults.([]interface{}) {
+		result := result.(map[string]interface{})
+		results[result[paramMirrorTopicName].(string)] = result
+	}
+	for mirrorTopicName, result := range retriedResults {
+		results[mirrorTopicName] = result
+	}
+	sortedResults := sortClusterLinkFailoverResults(results)
+	if err := d.Set(paramMirrorTopics, sortedResults); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	if failedMirrorTopicNames := extractFailedClusterLinkFailoverMirrorTopicNames(sortedResults); len(failedMirrorTopicNames) > 0 {
+		tflog.Warn(ctx, fmt.Sprintf("%q action of Cluster Link Failover %q failed again for %d of %d Kafka Mirror Topics", action, d.Id(), len(failedMirrorTopicNames), len(sortedResults)), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+		diags := clusterLinkFailoverRead(ctx, d, meta)
+		return append(diags, clusterLinkFailoverPartiallyAppliedWarning(action, failedMirrorTopicNames, len(sortedResults)))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished retrying %q action of Cluster Link Failover %q", action, d.Id()), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+
+	return clusterLinkFailoverRead(ctx, d, meta)
+}
+
+func clusterLinkFailoverDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// An applied action can't be undone, so clusterLinkFailoverDelete() only removes the resource from the TF state.
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Cluster Link Failover %q", d.Id()), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Cluster Link Failover %q", d.Id()), map[string]interface{}{clusterLinkLoggingKey: d.Id()})
+
+	return nil
+}
+
+// applyClusterLinkFailoverAction applies the action to the given mirror topics, except for the ones with one of
+// skippedStatuses, waits for them to reach the target status, and returns the result for each of them.
+// An error is returned only when the action couldn't be sent to Kafka REST API at all.
+func applyClusterLinkFailoverAction(ctx context.Context, c *KafkaRestClient, linkName, action string, mirrorTopicNames []string, mirrorTopicStatuses map[string]string, skippedStatuses []string, meta interface{}) (map[string]map[string]interface{}, error) {
+	actionSpec := clusterLinkFailoverActionSpecs[action]
+	results := make(map[string]map[string]interface{})
+	var pendingMirrorTopicNames []string
+	for _, mirrorTopicName := range mirrorTopicNames {
+		// Skip mirror topics the action wouldn't change, for example, after a previous partially failed run
+		if mirrorTopicStatus := mirrorTopicStatuses[mirrorTopicName]; stringInSlice(mirrorTopicStatus, skippedStatuses, false) {
+			results[mirrorTopicName] = clusterLinkFailoverResult(mirrorTopicName, mirrorTopicStatus, 0, "")
+			continue
+		}
+		pendingMirrorTopicNames = append(pendingMirrorTopicNames, mirrorTopicName)
+	}
+	if len(pendingMirrorTopicNames) == 0 {
+		return results, nil
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Applying %q action to Kafka Mirror Topics %v of Cluster Link %q", action, pendingMirrorTopicNames, linkName))
+	alterMirrorStatuses, resp, err := executeKafkaMirrorTopicsAction(ctx, c, linkName, action, pendingMirrorTopicNames)
+	if err != nil {
+		return nil, createDescriptiveError(err, resp)
+	}
+	for _, alterMirrorStatus := range alterMirrorStatuses.Data {
+		if alterMirrorStatus.GetErrorCode() != 0 || alterMirrorStatus.GetErrorMessage() != "" {
+			mirrorTopicName := alterMirrorStatus.GetMirrorTopicName()
+			results[mirrorTopicName] = clusterLinkFailoverResult(mirrorTopicName, mirrorTopicStatuses[mirrorTopicName], int(alterMirrorStatus.GetErrorCode()), alterMirrorStatus.GetErrorMessage())
+		}
+	}
+	for _, mirrorTopicName := range pendingMirrorTopicNames {
+		if _, failed := results[mirrorTopicName]; failed {
+			continue
+		}
+		currentStatus := mirrorTopicStatuses[mirrorTopicName]
+		if err := waitForClusterLinkFailoverActionToComplete(c.apiContext(ctx), c, c.clusterId, linkName, mirrorTopicName, currentStatus, actionSpec, meta.(*Client).isAcceptanceTestMode); err != nil {
+			results[mirrorTopicName] = clusterLinkFailoverResult(mirrorTopicName, currentStatus, 0, fmt.Sprintf("error waiting for Kafka Mirror Topic to be updated: %s", createDescriptiveError(err)))
+			continue
+		}
+		results[mirrorTopicName] = clusterLinkFailoverResult(mirrorTopicName, actionSpec.TargetStatus, 0, "")
+	}
+	return results, nil
+}
+
+func executeKafkaMirrorTopicsAction(ctx context.Context, c *KafkaRestClient, linkName, action string, mirrorTopicNames []string) (kafkarestv3.AlterMirrorStatusResponseDataList, *http.Response, error) {
+	requestData := kafkarestv3.AlterMirrorsRequestData{
+		MirrorTopicNames: &mirrorTopicNames,
+	}
+	switch action {
+	case clusterLinkFailoverActionFailover:
+		return c.apiClient.ClusterLinkingV3Api.UpdateKafkaMirrorTopicsFailover(c.apiContext(ctx), c.clusterId, linkName).AlterMirrorsRequestData(requestData).Execute()
+	case clusterLinkFailoverActionPromote:
+		return c.apiClient.ClusterLinkingV3Api.UpdateKafkaMirrorTopicsPromote(c.apiContext(ctx), c.clusterId, linkName).AlterMirrorsRequestData(requestData).Execute()
+	}
+	var alterMirrorStatuses kafkarestv3.AlterMirrorStatusResponseDataList
+	resp, err := c.executeRawRequest(ctx, http.MethodPost, fmt.Sprintf("/kafka/v3/clusters/%s/links/%s/mirrors:%s", url.PathEscape(c.clusterId), url.PathEscape(linkName), clusterLinkFailbackActionPaths[action]), requestData, &alterMirrorStatuses)
+	return alterMirrorStatuses, resp, err
+}
+
+func extractMirrorTopicStatuses(mirrorTopics []kafkarestv3.ListMirrorTopicsResponseData) map[string]string {
+	mirrorTopicStatuses := make(map[string]string)
+	for _, mirrorTopic := range mirrorTopics {
+		mirrorTopicStatuses[mirrorTopic.GetMirrorTopicName()] = string(mirrorTopic.GetMirrorStatus())
+	}
+	return mirrorTopicStatuses
+}
+
+// selectClusterLinkFailoverMirrorTopics returns the sorted names of the mirror topics to apply an action to:
+// the given names, the names that match the given pattern, or all mirror topics of the Cluster Link otherwise.
+func selectClusterLinkFailoverMirrorTopics(mirrorTopicStatuses map[string]string, mirrorTopicNames []string, mirrorTopicNamePattern string) ([]string, error) {
+	var selectedMirrorTopicNames []string
+	if len(mirrorTopicNames) > 0 {
+		var missingMirrorTopicNames []string
+		for _, mirrorTopicName := range mirrorTopicNames {
+			if _, ok := mirrorTopicStatuses[mirrorTopicName]; !ok {
+				missingMirrorTopicNames = append(missingMirrorTopicNames, mirrorTopicName)
+			}
+		}
+		if len(missingMirrorTopicNames) > 0 {
+			sort.Strings(missingMirrorTopicNames)
+			return nil, fmt.Errorf("the following Kafka Mirror Topics don't exist on the Cluster Link: %s", strings.Join(missingMirrorTopicNames, ", "))
+		}
+		selectedMirrorTopicNames = append(selectedMirrorTopicNames, mirrorTopicNames...)
+	} else {
+		var pattern *regexp.Regexp
+		if mirrorTopicNamePattern != "" {
+			var err error
+			pattern, err = regexp.Compile(mirrorTopicNamePattern)
+			if err != nil {
+				return nil, fmt.Errorf("error compiling %q: %s", paramMirrorTopicNamePattern, err)
+			}
+		}
+		for mirrorTopicName := range mirrorTopicStatuses {
+			if pattern == nil || pattern.MatchString(mirrorTopicName) {
+				selectedMirrorTopicNames = append(selectedMirrorTopicNames, mirrorTopicName)
+			}
+		}
+	}
+	if len(selectedMirrorTopicNames) == 0 {
+		return nil, fmt.Errorf("no Kafka Mirror Topics of the Cluster Link match %q or %q", paramMirrorTopicNames, paramMirrorTopicNamePattern)
+	}
+	sort.Strings(selectedMirrorTopicNames)
+	return selectedMirrorTopicNames, nil
+}
+
+func clusterLinkFailoverResult(mirrorTopicName, mirrorStatus string, errorCode int, errorMessage string) map[string]interface{} {
+	return map[string]interface{}{
+		paramMirrorTopicName: mirrorTopicName,
+		paramMirrorStatus:    mirrorStatus,
+		paramErrorCode:       errorCode,
+		paramErrorMessage:    errorMessage,
+	}
+}
+
+func sortClusterLinkFailoverResults(results map[string]map[string]interface{}) []interface{} {
+	mirrorTopicNames := make([]string, 0, len(results))
+	for mirrorTopicName := range results {
+		mirrorTopicNames = append(mirrorTopicNames, mirrorTopicName)
+	}
+	sort.Strings(mirrorTopicNames)
+	sortedResults := make([]interface{}, len(mirrorTopicNames))
+	for i, mirrorTopicName := range mirrorTopicNames {
+		sortedResults[i] = results[mirrorTopicName]
+	}
+	return sortedResults
+}
+
+// extractFailedClusterLinkFailoverMirrorTopicNames returns the names of the mirror topics the action failed for.
+func extractFailedClusterLinkFailoverMirrorTopicNames(results []interface{}) []string {
+	var failedMirrorTopicNames []string
+	for _, result := range results {
+		result := result.(map[string]interface{})
+		if result[paramErrorCode].(int) != 0 || result[paramErrorMessage].(string) != "" {
+			failedMirrorTopicNames = append(failedMirrorTopicNames, result[paramMirrorTopicName].(string))
+		}
+	}
+	return failedMirrorTopicNames
+}
+
+// Cluster Link Failover ID = lkc-kjnkvg/test_link/FAILOVER
+func createClusterLinkFailoverId(clusterId, linkName, action string) string {
+	return fmt.Sprintf("%s/%s/%s", clusterId, linkName, action)
+}
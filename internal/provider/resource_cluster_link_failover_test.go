@@ -0,0 +1,344 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+var fullClusterLinkFailoverResourceLabel = fmt.Sprintf("confluent_cluster_link_failover.%s", clusterLinkFailoverResourceLabel)
+
+var listFailoverKafkaMirrorTopicsPath = fmt.Sprintf("/kafka/v3/clusters/%s/links/%s/mirrors", destinationClusterId, clusterLinkName)
+var readFailoverKafkaMirrorTopicPath = fmt.Sprintf("/kafka/v3/clusters/%s/links/%s/mirrors/orders", destinationClusterId, clusterLinkName)
+var failoverKafkaMirrorTopicsPath = fmt.Sprintf("/kafka/v3/clusters/%s/links/%s/mirrors:failover", destinationClusterId, clusterLinkName)
+
+func TestAccClusterLinkFailover(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	listMirrorTopicsResponse, _ := ioutil.ReadFile("../testdata/cluster_link_failover/list_kafka_mirror_topics.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(listFailoverKafkaMirrorTopicsPath)).
+		InScenario(clusterLinkFailoverScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillReturn(
+			string(listMirrorTopicsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	failoverMirrorTopicsResponse, _ := ioutil.ReadFile("../testdata/cluster_link_failover/failover_kafka_mirror_topics.json")
+	failoverMirrorTopicsStub := wiremock.Post(wiremock.URLPathEqualTo(failoverKafkaMirrorTopicsPath)).
+		InScenario(clusterLinkFailoverScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.EqualToJson(`{"mirror_topic_names":["orders"]}`)).
+		WillSetStateTo(scenarioStateKafkaMirrorTopicsHaveBeenFailedOver).
+		WillReturn(
+			string(failoverMirrorTopicsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(failoverMirrorTopicsStub)
+
+	readFailedOverMirrorTopicResponse, _ := ioutil.ReadFile("../testdata/cluster_link_failover/read_failed_over_kafka_mirror_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readFailoverKafkaMirrorTopicPath)).
+		InScenario(clusterLinkFailoverScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenFailedOver).
+		WillReturn(
+			string(readFailedOverMirrorTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	listFailedOverMirrorTopicsResponse, _ := ioutil.ReadFile("../testdata/cluster_link_failover/list_failed_over_kafka_mirror_topics.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(listFailoverKafkaMirrorTopicsPath)).
+		InScenario(clusterLinkFailoverScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenFailedOver).
+		WillReturn(
+			string(listFailedOverMirrorTopicsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckClusterLinkFailoverConfig(confluentCloudBaseUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "id", fmt.Sprintf("%s/%s/%s", destinationClusterId, clusterLinkName, clusterLinkFailoverActionFailover)),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "action", clusterLinkFailoverActionFailover),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "cluster_link.#", "1"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "cluster_link.0.link_name", clusterLinkName),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "kafka_cluster.0.id", destinationClusterId),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.#", "2"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.mirror_topic_name", "orders"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.mirror_status", stateStopped),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.error_code", "0"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.error_message", ""),
+					// "payments" has already been failed over, so it's skipped
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.1.mirror_topic_name", "payments"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.1.mirror_status", stateStopped),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.1.error_code", "0"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, failoverMirrorTopicsStub, fmt.Sprintf("POST %s", failoverKafkaMirrorTopicsPath), expectedCountOne)
+}
+
+func TestSelectClusterLinkFailoverMirrorTopics(t *testing.T) {
+	mirrorTopicStatuses := map[string]string{
+		"orders":          stateActive,
+		"orders.internal": statePaused,
+		"payments":        stateStopped,
+	}
+	tests := []struct {
+		name                   string
+		mirrorTopicNames       []string
+		mirrorTopicNamePattern string
+		expectedNames          []string
+		expectError            bool
+	}{
+		{
+			name:          "all mirror topics",
+			expectedNames: []string{"orders", "orders.internal", "payments"},
+		},
+		{
+			name:             "mirror topic names",
+			mirrorTopicNames: []string{"payments", "orders"},
+			expectedNames:    []string{"orders", "payments"},
+		},
+		{
+			name:             "missing mirror topic",
+			mirrorTopicNames: []string{"orders", "refunds"},
+			expectError:      true,
+		},
+		{
+			name:                   "mirror topic name pattern",
+			mirrorTopicNamePattern: "^orders",
+			expectedNames:          []string{"orders", "orders.internal"},
+		},
+		{
+			name:                   "no matching mirror topics",
+			mirrorTopicNamePattern: "^refunds",
+			expectError:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualNames, err := selectClusterLinkFailoverMirrorTopics(mirrorTopicStatuses, tt.mirrorTopicNames, tt.mirrorTopicNamePattern)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", actualNames)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(actualNames, tt.expectedNames) {
+				t.Fatalf("expected %v, got %v", tt.expectedNames, actualNames)
+			}
+		})
+	}
+}
+
+func TestAccClusterLinkFailoverPartiallyApplied(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	listMirrorTopicsResponse, _ := ioutil.ReadFile("../testdata/cluster_link_failover/list_kafka_mirror_topics.json")
+	for _, scenarioState := range []string{wiremock.ScenarioStateStarted, scenarioStateKafkaMirrorTopicFailoverHasFailed} {
+		_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(listFailoverKafkaMirrorTopicsPath)).
+			InScenario(clusterLinkFailoverScenarioName).
+			WhenScenarioStateIs(scenarioState).
+			WillReturn(
+				string(listMirrorTopicsResponse),
+				contentTypeJSONHeader,
+				http.StatusOK,
+			))
+	}
+
+	// The first attempt to fail over "orders" fails
+	failedFailoverMirrorTopicsResponse, _ := ioutil.ReadFile("../testdata/cluster_link_failover/failover_kafka_mirror_topics_failed.json")
+	failedFailoverMirrorTopicsStub := wiremock.Post(wiremock.URLPathEqualTo(failoverKafkaMirrorTopicsPath)).
+		InScenario(clusterLinkFailoverScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.EqualToJson(`{"mirror_topic_names":["orders"]}`)).
+		WillSetStateTo(scenarioStateKafkaMirrorTopicFailoverHasFailed).
+		WillReturn(
+			string(failedFailoverMirrorTopicsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(failedFailoverMirrorTopicsStub)
+
+	failoverMirrorTopicsResponse, _ := ioutil.ReadFile("../testdata/cluster_link_failover/failover_kafka_mirror_topics.json")
+	failoverMirrorTopicsStub := wiremock.Post(wiremock.URLPathEqualTo(failoverKafkaMirrorTopicsPath)).
+		InScenario(clusterLinkFailoverScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicFailoverHasFailed).
+		WithBodyPattern(wiremock.EqualToJson(`{"mirror_topic_names":["orders"]}`)).
+		WillSetStateTo(scenarioStateKafkaMirrorTopicsHaveBeenFailedOver).
+		WillReturn(
+			string(failoverMirrorTopicsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(failoverMirrorTopicsStub)
+
+	readFailedOverMirrorTopicResponse, _ := ioutil.ReadFile("../testdata/cluster_link_failover/read_failed_over_kafka_mirror_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(readFailoverKafkaMirrorTopicPath)).
+		InScenario(clusterLinkFailoverScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenFailedOver).
+		WillReturn(
+			string(readFailedOverMirrorTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	listFailedOverMirrorTopicsResponse, _ := ioutil.ReadFile("../testdata/cluster_link_failover/list_failed_over_kafka_mirror_topics.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(listFailoverKafkaMirrorTopicsPath)).
+		InScenario(clusterLinkFailoverScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenFailedOver).
+		WillReturn(
+			string(listFailedOverMirrorTopicsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The action fails for "orders", and the resource isn't tainted
+				Config: testAccCheckClusterLinkFailoverConfig(confluentCloudBaseUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "id", fmt.Sprintf("%s/%s/%s", destinationClusterId, clusterLinkName, clusterLinkFailoverActionFailover)),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.#", "2"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.mirror_topic_name", "orders"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.mirror_status", stateActive),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.error_code", "40002"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.error_message", "Topic 'orders' is not a mirror topic that can be failed over."),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.1.mirror_topic_name", "payments"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.1.error_code", "0"),
+				),
+				// The action is planned to be retried for "orders"
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The action is retried for "orders" only
+				Config: testAccCheckClusterLinkFailoverConfig(confluentCloudBaseUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.#", "2"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.mirror_topic_name", "orders"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.mirror_status", stateStopped),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.error_code", "0"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.0.error_message", ""),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.1.mirror_topic_name", "payments"),
+					resource.TestCheckResourceAttr(fullClusterLinkFailoverResourceLabel, "mirror_topics.1.mirror_status", stateStopped),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, failedFailoverMirrorTopicsStub, fmt.Sprintf("POST %s", failoverKafkaMirrorTopicsPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, failoverMirrorTopicsStub, fmt.Sprintf("POST %s", failoverKafkaMirrorTopicsPath), expectedCountOne)
+}
+
+func TestClusterLinkFailoverActionSpecs(t *testing.T) {
+	for _, action := range acceptedClusterLinkFailoverActions {
+		if _, ok := clusterLinkFailoverActionSpecs[action]; !ok {
+			t.Errorf("expected %q action to have a spec", action)
+		}
+	}
+
+	// Only the actions that wouldn't change a mirror topic with the target status skip it
+	for action, expectedNoOp := range map[string]bool{
+		clusterLinkFailoverActionFailover:                 true,
+		clusterLinkFailoverActionPromote:                  false,
+		clusterLinkFailoverActionReverseAndStartMirror:    false,
+		clusterLinkFailoverActionReverseAndPauseMirror:    false,
+		clusterLinkFailoverActionTruncateAndRestoreMirror: true,
+	} {
+		actionSpec := clusterLinkFailoverActionSpecs[action]
+		if actualNoOp := stringInSlice(actionSpec.TargetStatus, actionSpec.NoOpStatuses, false); actualNoOp != expectedNoOp {
+			t.Errorf("expected %q action to be a no-op for %q mirror topics: %t, got %t", action, actionSpec.TargetStatus, expectedNoOp, actualNoOp)
+		}
+	}
+}
+
+func testAccCheckClusterLinkFailoverConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+	  endpoint = "%s"
+	}
+	resource "confluent_cluster_link_failover" "%s" {
+      action = "%s"
+      cluster_link {
+        link_name = "%s"
+      }
+      kafka_cluster {
+        id = "%s"
+        rest_endpoint = "%s"
+        credentials {
+		  key = "%s"
+		  secret = "%s"
+	    }
+      }
+	}
+	`, confluentCloudBaseUrl, clusterLinkFailoverResourceLabel, clusterLinkFailoverActionFailover, clusterLinkName,
+		destinationClusterId, mockServerUrl, destinationClusterApiKey, destinationClusterApiSecret)
+}
@@ -0,0 +1,416 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	autoCreateMirrorTopicsFiltersClusterLinkSetting = "auto.create.mirror.topics.filters"
+	consumerOffsetGroupFiltersClusterLinkSetting    = "consumer.offset.group.filters"
+	aclFiltersClusterLinkSetting                    = "acl.filters"
+
+	clusterLinkFilterPatternTypeLiteral  = "LITERAL"
+	clusterLinkFilterPatternTypePrefixed = "PREFIXED"
+	clusterLinkFilterTypeInclude         = "INCLUDE"
+	clusterLinkFilterTypeExclude         = "EXCLUDE"
+	clusterLinkFilterWildcardName        = "*"
+)
+
+var acceptedClusterLinkFilterPatternTypes = []string{clusterLinkFilterPatternTypeLiteral, clusterLinkFilterPatternTypePrefixed}
+var acceptedClusterLinkFilterTypes = []string{clusterLinkFilterTypeInclude, clusterLinkFilterTypeExclude}
+var acceptedClusterLinkAclFilterResourceTypes = []string{"ANY", "TOPIC", "GROUP", "CLUSTER", "TRANSACTIONAL_ID", "DELEGATION_TOKEN"}
+var acceptedClusterLinkAclFilterPatternTypes = []string{"ANY", "MATCH", "LITERAL", "PREFIXED"}
+var acceptedClusterLinkAclFilterOperations = []string{"ANY", "ALL", "READ", "WRITE", "CREATE", "DELETE", "ALTER", "DESCRIBE", "CLUSTER_ACTION", "DESCRIBE_CONFIGS", "ALTER_CONFIGS", "IDEMPOTENT_WRITE"}
+var acceptedClusterLinkAclFilterPermissions = []string{"ANY", "DENY", "ALLOW"}
+
+// clusterLinkFilterBlockSettings maps typed filter blocks to the cluster link settings they're serialized into.
+var clusterLinkFilterBlockSettings = map[string]string{
+	paramMirrorTopicFilter:   autoCreateMirrorTopicsFiltersClusterLinkSetting,
+	paramConsumerGroupFilter: consumerOffsetGroupFiltersClusterLinkSetting,
+	paramAclFilter:           aclFiltersClusterLinkSetting,
+}
+
+// The JSON formats of the filter settings are described at
+// https://docs.confluent.io/cloud/current/multi-cloud/cluster-linking/cluster-links-cc.html#configuring-cluster-link-behavior
+type clusterLinkNameFilter struct {
+	Name        string `json:"name"`
+	PatternType string `json:"patternType,omitempty"`
+	FilterType  string `json:"filterType,omitempty"`
+}
+
+type clusterLinkTopicFilters struct {
+	TopicFilters []clusterLinkNameFilter `json:"topicFilters"`
+}
+
+type clusterLinkGroupFilters struct {
+	GroupFilters []clusterLinkNameFilter `json:"groupFilters"`
+}
+
+type clusterLinkAclResourceFilter struct {
+	ResourceType string `json:"resourceType"`
+	Name         string `json:"name,omitempty"`
+	PatternType  string `json:"patternType,omitempty"`
+}
+
+type clusterLinkAclAccessFilter struct {
+	Principal      string `json:"principal,omitempty"`
+	Host           string `json:"host,omitempty"`
+	Operation      string `json:"operation"`
+	PermissionType string `json:"permissionType"`
+}
+
+type clusterLinkAclFilter struct {
+	ResourceFilter clusterLinkAclResourceFilter `json:"resourceFilter"`
+	AccessFilter   clusterLinkAclAccessFilter   `json:"accessFilter"`
+}
+
+type clusterLinkAclFilters struct {
+	AclFilters []clusterLinkAclFilter `json:"aclFilters"`
+}
+
+func clusterLinkNameFilterSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramName: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The name or the prefix to match, or `*` to match all names.",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				paramPatternType: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      clusterLinkFilterPatternTypeLiteral,
+					ValidateFunc: validation.StringInSlice(acceptedClusterLinkFilterPatternTypes, false),
+				},
+				paramFilterType: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      clusterLinkFilterTypeInclude,
+					ValidateFunc: validation.StringInSlice(acceptedClusterLinkFilterTypes, false),
+				},
+			},
+		},
+	}
+}
+
+func clusterLinkAclFilterSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "The filters that select the ACLs to sync from the source cluster, serialized into the `acl.filters` cluster link setting.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramResourceType: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(acceptedClusterLinkAclFilterResourceTypes, false),
+				},
+				paramResourceName: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The resource name to match. Matches any resource name if omitted.",
+				},
+				paramPatternType: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "ANY",
+					ValidateFunc: validation.StringInSlice(acceptedClusterLinkAclFilterPatternTypes, false),
+				},
+				paramPrincipal: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The principal to match, for example, `User:sa-abc123`. Matches any principal if omitted.",
+				},
+				paramHost: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The host to match. Matches any host if omitted.",
+				},
+				paramOperation: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(acceptedClusterLinkAclFilterOperations, false),
+				},
+				paramPermission: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(acceptedClusterLinkAclFilterPermissions, false),
+				},
+			},
+		},
+	}
+}
+
+// clusterLinkFiltersCustomizeDiff validates filter blocks and JSON filter settings in 'config' block during `terraform plan`.
+// 'config' block is Optional and Computed, so only the cluster link settings written in TF configuration are checked
+// instead of the ones read from Kafka REST API.
+func clusterLinkFiltersCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	configs := extractClusterLinkSettingsFromRawConfig(diff.GetRawConfig())
+	for blockName, settingName := range clusterLinkFilterBlockSettings {
+		settingValue, isSetInConfigs := configs[settingName]
+		filters := diff.Get(blockName).([]interface{})
+		if isSetInConfigs && len(filters) > 0 {
+			return fmt.Errorf("error creating / updating Cluster Link: %q block conflicts with %s[%q]. Use one of them", blockName, paramConfigs, settingName)
+		}
+		if isSetInConfigs && settingValue.IsKnown() && !settingValue.IsNull() {
+			if _, err := parseClusterLinkFilters(blockName, settingValue.AsString()); err != nil {
+				return fmt.Errorf("error creating / updating Cluster Link: invalid %s[%q]: %s", paramConfigs, settingName, err)
+			}
+		}
+		if !diff.NewValueKnown(blockName) {
+			continue
+		}
+		if _, err := serializeClusterLinkFilters(blockName, filters); err != nil {
+			return fmt.Errorf("error creating / updating Cluster Link: invalid %q block: %s", blockName, err)
+		}
+	}
+	return nil
+}
+
+// extractClusterLinkSettingsFromRawConfig returns the cluster link settings written in 'config' block of TF configuration.
+func extractClusterLinkSettingsFromRawConfig(rawConfig cty.Value) map[string]cty.Value {
+	rawConfigs := rawConfig.GetAttr(paramConfigs)
+	if rawConfigs.IsNull() || !rawConfigs.IsKnown() || rawConfigs.LengthInt() == 0 {
+		return nil
+	}
+	return rawConfigs.AsValueMap()
+}
+
+// suppressEquivalentClusterLinkFilterSettings suppresses diffs of JSON filter settings in 'config' block
+// that only differ in formatting, since Kafka REST API returns them normalized.
+func suppressEquivalentClusterLinkFilterSettings(k, old, new string, _ *schema.ResourceData) bool {
+	for blockName, settingName := range clusterLinkFilterBlockSettings {
+		if k != fmt.Sprintf("%s.%s", paramConfigs, settingName) {
+			continue
+		}
+		oldFilters, err := parseClusterLinkFilters(blockName, old)
+		if err != nil {
+			return false
+		}
+		newFilters, err := parseClusterLinkFilters(blockName, new)
+		if err != nil {
+			return false
+		}
+		oldValue, _ := serializeClusterLinkFilters(blockName, oldFilters)
+		newValue, _ := serializeClusterLinkFilters(blockName, newFilters)
+		return oldValue == newValue
+	}
+	return false
+}
+
+// extractClusterLinkFilterSettings returns cluster link settings for filter blocks that are set.
+func extractClusterLinkFilterSettings(d *schema.ResourceData) (map[string]string, error) {
+	settings := make(map[string]string)
+	for blockName, settingName := range clusterLinkFilterBlockSettings {
+		filters := d.Get(blockName).([]interface{})
+		if len(filters) == 0 {
+			continue
+		}
+		settingValue, err := serializeClusterLinkFilters(blockName, filters)
+		if err != nil {
+			return nil, err
+		}
+		settings[settingName] = settingValue
+	}
+	return settings, nil
+}
+
+// setClusterLinkFilterAttributes moves filter settings that are not managed via 'config' block
+// from the configs to the corresponding filter blocks.
+func setClusterLinkFilterAttributes(d *schema.ResourceData, configs map[string]string) error {
+	managedConfigs := d.Get(paramConfigs).(map[string]interface{})
+	for blockName, settingName := range clusterLinkFilterBlockSettings {
+		// Filter blocks take precedence over 'config' block, which also contains the settings read from Kafka REST API
+		if _, isManagedInConfigs := managedConfigs[settingName]; isManagedInConfigs && len(d.Get(blockName).([]interface{})) == 0 {
+			continue
+		}
+		filters := make([]interface{}, 0)
+		if settingValue, ok := configs[settingName]; ok {
+			parsedFilters, err := parseClusterLinkFilters(blockName, settingValue)
+			if err != nil {
+				return fmt.Errorf("error reading %q cluster link setting: %s", settingName, err)
+			}
+			filters = parsedFilters
+			delete(configs, settingName)
+		}
+		if err := d.Set(blockName, filters); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isClusterLinkSettingManagedByFilterBlock returns true if a filter setting moved from 'config' block to its filter block.
+func isClusterLinkSettingManagedByFilterBlock(d *schema.ResourceData, settingName string) bool {
+	for blockName, filterSettingName := range clusterLinkFilterBlockSettings {
+		if filterSettingName == settingName {
+			return len(d.Get(blockName).([]interface{})) > 0
+		}
+	}
+	return false
+}
+
+func serializeClusterLinkFilters(blockName string, filters []interface{}) (string, error) {
+	var value interface{}
+	if blockName == paramAclFilter {
+		aclFilters := clusterLinkAclFilters{AclFilters: make([]clusterLinkAclFilter, len(filters))}
+		for i, filter := range filters {
+			filterMap := filter.(map[string]interface{})
+			// ACL filters are documented in lower case
+			aclFilters.AclFilters[i] = clusterLinkAclFilter{
+				ResourceFilter: clusterLinkAclResourceFilter{
+					ResourceType: strings.ToLower(filterMap[paramResourceType].(string)),
+					Name:         filterMap[paramResourceName].(string),
+					PatternType:  strings.ToLower(filterMap[paramPatternType].(string)),
+				},
+				AccessFilter: clusterLinkAclAccessFilter{
+					Principal:      filterMap[paramPrincipal].(string),
+					Host:           filterMap[paramHost].(string),
+					Operation:      strings.ToLower(filterMap[paramOperation].(string)),
+					PermissionType: strings.ToLower(filterMap[paramPermission].(string)),
+				},
+			}
+		}
+		value = aclFilters
+	} else {
+		nameFilters := make([]clusterLinkNameFilter, len(filters))
+		for i, filter := range filters {
+			filterMap := filter.(map[string]interface{})
+			nameFilters[i] = clusterLinkNameFilter{
+				Name:        filterMap[paramName].(string),
+				PatternType: filterMap[paramPatternType].(string),
+				FilterType:  filterMap[paramFilterType].(string),
+			}
+			if nameFilters[i].Name == clusterLinkFilterWildcardName && nameFilters[i].PatternType != clusterLinkFilterPatternTypeLiteral {
+				return "", fmt.Errorf("%q name must be used with %q %s", clusterLinkFilterWildcardName, clusterLinkFilterPatternTypeLiteral, paramPatternType)
+			}
+		}
+		if blockName == paramMirrorTopicFilter {
+			value = clusterLinkTopicFilters{TopicFilters: nameFilters}
+		} else {
+			value = clusterLinkGroupFilters{GroupFilters: nameFilters}
+		}
+	}
+	valueJson, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling %#v to json: %s", value, err)
+	}
+	return string(valueJson), nil
+}
+
+func parseClusterLinkFilters(blockName, value string) ([]interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	// Catch unknown field names, for example, "pattern_type" instead of "patternType"
+	decoder.DisallowUnknownFields()
+
+	if blockName == paramAclFilter {
+		var aclFilters clusterLinkAclFilters
+		if err := decoder.Decode(&aclFilters); err != nil {
+			return nil, err
+		}
+		filters := make([]interface{}, len(aclFilters.AclFilters))
+		for i, aclFilter := range aclFilters.AclFilters {
+			patternType := aclFilter.ResourceFilter.PatternType
+			if patternType == "" {
+				patternType = "ANY"
+			}
+			filterMap := map[string]interface{}{
+				paramResourceType: strings.ToUpper(aclFilter.ResourceFilter.ResourceType),
+				paramResourceName: aclFilter.ResourceFilter.Name,
+				paramPatternType:  strings.ToUpper(patternType),
+				paramPrincipal:    aclFilter.AccessFilter.Principal,
+				paramHost:         aclFilter.AccessFilter.Host,
+				paramOperation:    strings.ToUpper(aclFilter.AccessFilter.Operation),
+				paramPermission:   strings.ToUpper(aclFilter.AccessFilter.PermissionType),
+			}
+			if err := validateClusterLinkFilterValue("resourceType", filterMap[paramResourceType].(string), acceptedClusterLinkAclFilterResourceTypes); err != nil {
+				return nil, err
+			}
+			if err := validateClusterLinkFilterValue("patternType", filterMap[paramPatternType].(string), acceptedClusterLinkAclFilterPatternTypes); err != nil {
+				return nil, err
+			}
+			if err := validateClusterLinkFilterValue("operation", filterMap[paramOperation].(string), acceptedClusterLinkAclFilterOperations); err != nil {
+				return nil, err
+			}
+			if err := validateClusterLinkFilterValue("permissionType", filterMap[paramPermission].(string), acceptedClusterLinkAclFilterPermissions); err != nil {
+				return nil, err
+			}
+			filters[i] = filterMap
+		}
+		return filters, nil
+	}
+
+	var nameFilters []clusterLinkNameFilter
+	if blockName == paramMirrorTopicFilter {
+		var topicFilters clusterLinkTopicFilters
+		if err := decoder.Decode(&topicFilters); err != nil {
+			return nil, err
+		}
+		nameFilters = topicFilters.TopicFilters
+	} else {
+		var groupFilters clusterLinkGroupFilters
+		if err := decoder.Decode(&groupFilters); err != nil {
+			return nil, err
+		}
+		nameFilters = groupFilters.GroupFilters
+	}
+	filters := make([]interface{}, len(nameFilters))
+	for i, nameFilter := range nameFilters {
+		patternType := strings.ToUpper(nameFilter.PatternType)
+		if patternType == "" {
+			patternType = clusterLinkFilterPatternTypeLiteral
+		}
+		filterType := strings.ToUpper(nameFilter.FilterType)
+		if filterType == "" {
+			filterType = clusterLinkFilterTypeInclude
+		}
+		if strings.TrimSpace(nameFilter.Name) == "" {
+			return nil, fmt.Errorf("%q must not be empty", "name")
+		}
+		if err := validateClusterLinkFilterValue("patternType", patternType, acceptedClusterLinkFilterPatternTypes); err != nil {
+			return nil, err
+		}
+		if err := validateClusterLinkFilterValue("filterType", filterType, acceptedClusterLinkFilterTypes); err != nil {
+			return nil, err
+		}
+		filters[i] = map[string]interface{}{
+			paramName:        nameFilter.Name,
+			paramPatternType: patternType,
+			paramFilterType:  filterType,
+		}
+	}
+	return filters, nil
+}
+
+func validateClusterLinkFilterValue(name, value string, acceptedValues []string) error {
+	if !stringInSlice(value, acceptedValues, false) {
+		return fmt.Errorf("%q must be one of %s, got %q", name, strings.Join(acceptedValues, ", "), value)
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestSerializeClusterLinkFilters(t *testing.T) {
+	tests := []struct {
+		name          string
+		blockName     string
+		filters       []interface{}
+		expectedValue string
+		expectError   bool
+	}{
+		{
+			name:      "mirror topic filters",
+			blockName: paramMirrorTopicFilter,
+			filters: []interface{}{
+				map[string]interface{}{paramName: "orders", paramPatternType: "PREFIXED", paramFilterType: "INCLUDE"},
+				map[string]interface{}{paramName: "orders.internal", paramPatternType: "LITERAL", paramFilterType: "EXCLUDE"},
+			},
+			expectedValue: `{"topicFilters":[{"name":"orders","patternType":"PREFIXED","filterType":"INCLUDE"},{"name":"orders.internal","patternType":"LITERAL","filterType":"EXCLUDE"}]}`,
+		},
+		{
+			name:      "consumer group filters",
+			blockName: paramConsumerGroupFilter,
+			filters: []interface{}{
+				map[string]interface{}{paramName: "*", paramPatternType: "LITERAL", paramFilterType: "INCLUDE"},
+			},
+			expectedValue: `{"groupFilters":[{"name":"*","patternType":"LITERAL","filterType":"INCLUDE"}]}`,
+		},
+		{
+			name:          "no consumer group filters",
+			blockName:     paramConsumerGroupFilter,
+			filters:       []interface{}{},
+			expectedValue: `{"groupFilters":[]}`,
+		},
+		{
+			name:      "wildcard with prefixed pattern type",
+			blockName: paramMirrorTopicFilter,
+			filters: []interface{}{
+				map[string]interface{}{paramName: "*", paramPatternType: "PREFIXED", paramFilterType: "INCLUDE"},
+			},
+			expectError: true,
+		},
+		{
+			name:      "acl filters",
+			blockName: paramAclFilter,
+			filters: []interface{}{
+				map[string]interface{}{
+					paramResourceType: "TOPIC",
+					paramResourceName: "orders",
+					paramPatternType:  "PREFIXED",
+					paramPrincipal:    "User:sa-abc123",
+					paramHost:         "",
+					paramOperation:    "READ",
+					paramPermission:   "ALLOW",
+				},
+			},
+			expectedValue: `{"aclFilters":[{"resourceFilter":{"resourceType":"topic","name":"orders","patternType":"prefixed"},"accessFilter":{"principal":"User:sa-abc123","operation":"read","permissionType":"allow"}}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualValue, err := serializeClusterLinkFilters(tt.blockName, tt.filters)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got %s", actualValue)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if actualValue != tt.expectedValue {
+				t.Fatalf("expected %s, got %s", tt.expectedValue, actualValue)
+			}
+			// Serialized filters should be parsed back to the same blocks
+			parsedFilters, err := parseClusterLinkFilters(tt.blockName, actualValue)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(parsedFilters, tt.filters) {
+				t.Fatalf("expected %v, got %v", tt.filters, parsedFilters)
+			}
+		})
+	}
+}
+
+func TestParseClusterLinkFilters(t *testing.T) {
+	tests := []struct {
+		name            string
+		blockName       string
+		value           string
+		expectedFilters []interface{}
+		expectError     bool
+	}{
+		{
+			name:      "defaults and normalized case",
+			blockName: paramMirrorTopicFilter,
+			value:     `{ "topicFilters": [ { "name": "orders", "patternType": "prefixed" } ] }`,
+			expectedFilters: []interface{}{
+				map[string]interface{}{paramName: "orders", paramPatternType: "PREFIXED", paramFilterType: "INCLUDE"},
+			},
+		},
+		{
+			name:        "typo in field name",
+			blockName:   paramConsumerGroupFilter,
+			value:       `{"groupFilters":[{"name":"*","pattern_type":"LITERAL"}]}`,
+			expectError: true,
+		},
+		{
+			name:        "invalid pattern type",
+			blockName:   paramConsumerGroupFilter,
+			value:       `{"groupFilters":[{"name":"app","patternType":"PREFIX"}]}`,
+			expectError: true,
+		},
+		{
+			name:        "topic filters in consumer group filters",
+			blockName:   paramConsumerGroupFilter,
+			value:       `{"topicFilters":[{"name":"orders"}]}`,
+			expectError: true,
+		},
+		{
+			name:      "acl filters",
+			blockName: paramAclFilter,
+			value:     `{"aclFilters":[{"resourceFilter":{"resourceType":"any"},"accessFilter":{"operation":"any","permissionType":"any"}}]}`,
+			expectedFilters: []interface{}{
+				map[string]interface{}{
+					paramResourceType: "ANY",
+					paramResourceName: "",
+					paramPatternType:  "ANY",
+					paramPrincipal:    "",
+					paramHost:         "",
+					paramOperation:    "ANY",
+					paramPermission:   "ANY",
+				},
+			},
+		},
+		{
+			name:        "invalid acl operation",
+			blockName:   paramAclFilter,
+			value:       `{"aclFilters":[{"resourceFilter":{"resourceType":"topic"},"accessFilter":{"operation":"reed","permissionType":"allow"}}]}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actualFilters, err := parseClusterLinkFilters(tt.blockName, tt.value)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", actualFilters)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(actualFilters, tt.expectedFilters) {
+				t.Fatalf("expected %v, got %v", tt.expectedFilters, actualFilters)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentClusterLinkFilterSettings(t *testing.T) {
+	topicFiltersKey := fmt.Sprintf("%s.%s", paramConfigs, autoCreateMirrorTopicsFiltersClusterLinkSetting)
+	if !suppressEquivalentClusterLinkFilterSettings(topicFiltersKey,
+		`{"topicFilters":[{"name":"orders","patternType":"PREFIXED","filterType":"INCLUDE"}]}`,
+		`{ "topicFilters": [ { "filterType": "INCLUDE", "name": "orders", "patternType": "PREFIXED" } ] }`, nil) {
+		t.Fatalf("expected a diff between equivalent filters to be suppressed")
+	}
+	if suppressEquivalentClusterLinkFilterSettings(topicFiltersKey,
+		`{"topicFilters":[{"name":"orders","patternType":"PREFIXED","filterType":"INCLUDE"}]}`,
+		`{"topicFilters":[{"name":"orders","patternType":"LITERAL","filterType":"INCLUDE"}]}`, nil) {
+		t.Fatalf("expected a diff between different filters not to be suppressed")
+	}
+	if suppressEquivalentClusterLinkFilterSettings(fmt.Sprintf("%s.%s", paramConfigs, "acl.sync.ms"), "5000", "5000 ", nil) {
+		t.Fatalf("expected a diff of a non-filter setting not to be suppressed")
+	}
+}
+
+func TestClusterLinkFiltersCustomizeDiff(t *testing.T) {
+	clusterLinkSchema := clusterLinkResource().Schema
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			paramConfigs:             clusterLinkSchema[paramConfigs],
+			paramMirrorTopicFilter:   clusterLinkSchema[paramMirrorTopicFilter],
+			paramConsumerGroupFilter: clusterLinkSchema[paramConsumerGroupFilter],
+			paramAclFilter:           clusterLinkSchema[paramAclFilter],
+		},
+		CustomizeDiff: clusterLinkFiltersCustomizeDiff,
+	}
+	// An existing cluster link whose mirror topic filters were read from Kafka REST API into 'config' block
+	existingClusterLinkAttributes := map[string]string{
+		"id":       fmt.Sprintf("%s/%s", destinationClusterId, clusterLinkName),
+		"config.%": "2",
+		"config.auto.create.mirror.topics.filters": `{"topicFilters":[{"name":"orders","patternType":"PREFIXED","filterType":"INCLUDE"}]}`,
+		"config.consumer.offset.sync.enable":       "true",
+		"mirror_topic_filter.#":                    "0",
+		"consumer_group_filter.#":                  "0",
+		"acl_filter.#":                             "0",
+	}
+
+	tests := []struct {
+		name        string
+		config      string
+		expectError bool
+	}{
+		{
+			name:   "filter blocks added to an existing cluster link without config block",
+			config: `{"mirror_topic_filter": [{"name": "orders", "pattern_type": "PREFIXED", "filter_type": "INCLUDE"}], "consumer_group_filter": [], "acl_filter": []}`,
+		},
+		{
+			name:   "filter blocks added to an existing cluster link with other settings in config block",
+			config: `{"config": {"consumer.offset.sync.enable": "true"}, "mirror_topic_filter": [{"name": "orders", "pattern_type": "PREFIXED", "filter_type": "INCLUDE"}], "consumer_group_filter": [], "acl_filter": []}`,
+		},
+		{
+			name:        "filter blocks conflict with filter settings in config block",
+			config:      `{"config": {"auto.create.mirror.topics.filters": "{\"topicFilters\":[]}"}, "mirror_topic_filter": [{"name": "orders", "pattern_type": "PREFIXED", "filter_type": "INCLUDE"}], "consumer_group_filter": [], "acl_filter": []}`,
+			expectError: true,
+		},
+		{
+			name:        "invalid filter settings in config block",
+			config:      `{"config": {"auto.create.mirror.topics.filters": "{\"topicFilters\":[{\"name\":\"*\",\"patternType\":\"PREFIXED\",\"filterType\":\"INCLUDE\"}]}"}, "mirror_topic_filter": [], "consumer_group_filter": [], "acl_filter": []}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configValue, err := ctyjson.Unmarshal([]byte(tt.config), r.CoreConfigSchema().ImpliedType())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			// Terraform sends the raw configuration along with the prior state during `terraform plan`
+			state := &terraform.InstanceState{ID: existingClusterLinkAttributes["id"], Attributes: existingClusterLinkAttributes, RawConfig: configValue}
+			_, err = r.Diff(context.Background(), state, terraform.NewResourceConfigShimmed(configValue, r.CoreConfigSchema()), nil)
+			if tt.expectError && err == nil {
+				t.Fatalf("expected an error")
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
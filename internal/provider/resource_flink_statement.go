@@ -622,7 +622,21 @@ func extractFlinkRestEndpoint(client *Client, d *schema.ResourceData, isImportOp
 	if restEndpoint != "" {
 		return restEndpoint, nil
 	}
-	return "", fmt.Errorf("one of provider.flink_rest_endpoint (defaults to FLINK_REST_ENDPOINT environment variable) or resource.rest_endpoint must be set")
+	if environment, ok := extractFlinkEnvironmentMetadataFromProviderBlock(client, d); ok && environment.restEndpoint != "" {
+		return environment.restEndpoint, nil
+	}
+	return "", fmt.Errorf("one of provider.flink_rest_endpoint (defaults to FLINK_REST_ENDPOINT environment variable), provider.flink_environments.rest_endpoint or resource.rest_endpoint must be set")
+}
+
+// extractFlinkEnvironmentMetadataFromProviderBlock returns the entry of the flink_environments blocks
+// of the provider block for the Environment of the resource, if any.
+func extractFlinkEnvironmentMetadataFromProviderBlock(client *Client, d *schema.ResourceData) (providerClusterMetadata, bool) {
+	environmentId, err := extractFlinkEnvironmentId(client, d, false)
+	if err != nil {
+		return providerClusterMetadata{}, false
+	}
+	environment, ok := client.flinkRestClientFactory.environments[environmentId]
+	return environment, ok
 }
 
 func extractFlinkApiKeyAndApiSecret(client *Client, d *schema.ResourceData, isImportOperation bool) (string, string, error) {
@@ -645,7 +659,10 @@ func extractFlinkApiKeyAndApiSecret(client *Client, d *schema.ResourceData, isIm
 	if clusterApiKey != "" {
 		return clusterApiKey, clusterApiSecret, nil
 	}
-	return "", "", fmt.Errorf("one of (provider.flink_api_key, provider.flink_api_secret), (FLINK_API_KEY, FLINK_API_SECRET environment variables) or (resource.credentials.key, resource.credentials.secret) must be set")
+	if environment, ok := extractFlinkEnvironmentMetadataFromProviderBlock(client, d); ok && environment.apiKey != "" {
+		return environment.apiKey, environment.apiSecret, nil
+	}
+	return "", "", fmt.Errorf("one of (provider.flink_api_key, provider.flink_api_secret), (FLINK_API_KEY, FLINK_API_SECRET environment variables), (provider.flink_environments.api_key, provider.flink_environments.api_secret) or (resource.credentials.key, resource.credentials.secret) must be set")
 }
 
 func extractFlinkOrganizationId(client *Client, d *schema.ResourceData, isImportOperation bool) (string, error) {
@@ -113,10 +113,17 @@ func kafkaAclResource() *schema.Resource {
 			paramRestEndpoint: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
+				Computed:     true,
 				Description:  "The REST endpoint of the Kafka cluster (e.g., `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
 				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
 			},
+			paramRestEndpointConnectionType: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{paramRestEndpoint},
+				ValidateFunc:  validation.StringIsNotEmpty,
+				Description:   "The connection type of the REST endpoint to look up for the Kafka cluster when `rest_endpoint` isn't set, for example, `PRIVATE_LINK`.",
+			},
 			paramCredentials: credentialsSchema(),
 		},
 		SchemaVersion: 2,
@@ -132,12 +139,12 @@ func kafkaAclResource() *schema.Resource {
 				Version: 1,
 			},
 		},
-		CustomizeDiff: customdiff.Sequence(resourceCredentialBlockValidationWithOAuth),
+		CustomizeDiff: customdiff.Sequence(resourceCredentialBlockValidationWithOAuth, restEndpointConnectionTypeCustomizeDiff),
 	}
 }
 
 func kafkaAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	restEndpoint, err := extractRestEndpointOrLookUp(ctx, meta.(*Client), d, false)
 	if err != nil {
 		return diag.Errorf("error creating Kafka ACLs: %s", createDescriptiveError(err))
 	}
@@ -192,7 +199,7 @@ func executeKafkaAclCreate(ctx context.Context, c *KafkaRestClient, requestData
 func kafkaAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Kafka ACLs %q", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
 
-	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	restEndpoint, err := extractRestEndpointOrLookUp(ctx, meta.(*Client), d, false)
 	if err != nil {
 		return diag.Errorf("error deleting Kafka ACLs: %s", createDescriptiveError(err))
 	}
@@ -230,7 +237,7 @@ func executeKafkaAclRead(ctx context.Context, c *KafkaRestClient, acl Acl) (kafk
 func kafkaAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka ACLs %q", d.Id()), map[string]interface{}{kafkaAclLoggingKey: d.Id()})
 
-	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	restEndpoint, err := extractRestEndpointOrLookUp(ctx, meta.(*Client), d, false)
 	if err != nil {
 		return diag.Errorf("error reading Kafka ACLs: %s", createDescriptiveError(err))
 	}
@@ -407,8 +414,8 @@ func deserializeAcl(serializedAcl string) (Acl, error) {
 }
 
 func kafkaAclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials) {
-		return diag.Errorf("error updating Kafka ACLs %q: only %q block can be updated for Kafka ACLs", d.Id(), paramCredentials)
+	if d.HasChangesExcept(paramCredentials, paramRestEndpoint, paramRestEndpointConnectionType) {
+		return diag.Errorf("error updating Kafka ACLs %q: only %q, %q and %q blocks can be updated for Kafka ACLs", d.Id(), paramCredentials, paramRestEndpoint, paramRestEndpointConnectionType)
 	}
 	return kafkaAclRead(ctx, d, meta)
 }
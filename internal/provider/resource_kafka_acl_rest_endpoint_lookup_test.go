@@ -0,0 +1,174 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/walkerus/go-wiremock"
+)
+
+func TestAccAclsWithRestEndpointLookup(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	readEnvironmentsResponse, _ := ioutil.ReadFile("../testdata/environment/read_envs.json")
+	listEnvironmentsStub := wiremock.Get(wiremock.URLPathEqualTo("/org/v2/environments")).
+		InScenario(aclWithRestEndpointLookupScenarioName).
+		WillReturn(
+			string(readEnvironmentsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(listEnvironmentsStub)
+
+	// The REST endpoint of the access point with PRIVATE_NETWORK_INTERFACE connection type points to the mock server
+	listKafkaClustersResponse, _ := ioutil.ReadFile("../testdata/kafka_acl/list_kafka_clusters_with_access_points.json")
+	listKafkaClustersStub := wiremock.Get(wiremock.URLPathEqualTo("/cmk/v2/clusters")).
+		WithQueryParam("environment", wiremock.EqualTo("env-1jrymj")).
+		InScenario(aclWithRestEndpointLookupScenarioName).
+		WillReturn(
+			strings.ReplaceAll(string(listKafkaClustersResponse), "{{MOCK_SERVER_URL}}", mockServerUrl),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(listKafkaClustersStub)
+
+	listNoKafkaClustersResponse, _ := ioutil.ReadFile("../testdata/kafka_acl/list_no_kafka_clusters.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/cmk/v2/clusters")).
+		WithQueryParam("environment", wiremock.EqualTo("env-ab123")).
+		InScenario(aclWithRestEndpointLookupScenarioName).
+		WillReturn(
+			string(listNoKafkaClustersResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	createAclStub := wiremock.Post(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		InScenario(aclWithRestEndpointLookupScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateAclHasBeenCreated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createAclStub)
+
+	readCreatedAclResponse, _ := ioutil.ReadFile("../testdata/kafka_acl/search_created_kafka_acls.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		InScenario(aclWithRestEndpointLookupScenarioName).
+		WhenScenarioStateIs(scenarioStateAclHasBeenCreated).
+		WillReturn(
+			string(readCreatedAclResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readEmptyAclResponse, _ := ioutil.ReadFile("../testdata/kafka_acl/search_deleted_kafka_acls.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		InScenario(aclWithRestEndpointLookupScenarioName).
+		WhenScenarioStateIs(scenarioStateAclHasBeenDeleted).
+		WillReturn(
+			string(readEmptyAclResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	readDeletedAclResponse, _ := ioutil.ReadFile("../testdata/kafka_acl/delete_kafka_acls.json")
+	deleteAclStub := wiremock.Delete(wiremock.URLPathEqualTo(createKafkaAclPath)).
+		InScenario(aclWithRestEndpointLookupScenarioName).
+		WhenScenarioStateIs(scenarioStateAclHasBeenCreated).
+		WillSetStateTo(scenarioStateAclHasBeenDeleted).
+		WillReturn(
+			string(readDeletedAclResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(deleteAclStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckAclDestroy(s, mockServerUrl)
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAclWithRestEndpointLookupConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAclExists(fullAclResourceLabel),
+					resource.TestCheckResourceAttr(fullAclResourceLabel, "kafka_cluster.0.id", clusterId),
+					resource.TestCheckResourceAttr(fullAclResourceLabel, "rest_endpoint_connection_type", "PRIVATE_NETWORK_INTERFACE"),
+					resource.TestCheckResourceAttr(fullAclResourceLabel, "rest_endpoint", mockServerUrl),
+				),
+			},
+		},
+	})
+
+	// The Kafka cluster is looked up once and cached for the rest of the run
+	checkStubCount(t, wiremockClient, listEnvironmentsStub, "GET /org/v2/environments", expectedCountOne)
+	checkStubCount(t, wiremockClient, listKafkaClustersStub, "GET /cmk/v2/clusters", expectedCountOne)
+	checkStubCount(t, wiremockClient, createAclStub, fmt.Sprintf("POST %s", createKafkaAclPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteAclStub, fmt.Sprintf("DELETE %s", readKafkaAclPath), expectedCountOne)
+}
+
+func testAccCheckAclWithRestEndpointLookupConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+	  endpoint = "%s"
+	}
+	resource "confluent_kafka_acl" "%s" {
+	  kafka_cluster {
+	    id = "%s"
+	  }
+	  resource_type = "%s"
+	  resource_name = "%s"
+	  pattern_type = "%s"
+	  principal = "%s"
+	  host = "*"
+	  operation = "%s"
+	  permission = "%s"
+
+	  rest_endpoint_connection_type = "PRIVATE_NETWORK_INTERFACE"
+
+	  credentials {
+	    key = "%s"
+	    secret = "%s"
+	  }
+	}
+	`, mockServerUrl, aclResourceLabel, clusterId, aclResourceType, aclResourceName, aclPatternType, aclPrincipalWithResourceId,
+		aclOperation, aclPermission, kafkaApiKey, kafkaApiSecret)
+}
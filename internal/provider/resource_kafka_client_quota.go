@@ -21,12 +21,16 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	cmkv2 "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
 	kafkaquotasv1 "github.com/confluentinc/ccloud-sdk-go-v2/kafka-quotas/v1"
 )
 
@@ -63,9 +67,132 @@ func kafkaClientQuotaResource() *schema.Resource {
 			},
 			paramThroughput: throughputSchema(),
 		},
+		CustomizeDiff: kafkaClientQuotaCustomizeDiff,
 	}
 }
 
+// The throughput limits of Kafka cluster types, see https://docs.confluent.io/cloud/current/clusters/cluster-types.html
+const (
+	bytesPerMegabyte                         = 1000 * 1000
+	kafkaClientQuotaMaxIngressByteRate       = 250 * bytesPerMegabyte
+	kafkaClientQuotaMaxEgressByteRate        = 750 * bytesPerMegabyte
+	kafkaClientQuotaMaxIngressByteRatePerCku = 60 * bytesPerMegabyte
+	kafkaClientQuotaMaxEgressByteRatePerCku  = 180 * bytesPerMegabyte
+)
+
+// kafkaClientQuotaCustomizeDiff flags principals that are already covered by another client quota of the Kafka cluster
+// and throughput values above the limits of the Kafka cluster type during `terraform plan` instead of `terraform apply`.
+// Both checks are skipped with a warning when the client quotas or the Kafka cluster can't be read.
+func kafkaClientQuotaCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges(paramPrincipals, paramThroughput) {
+		return nil
+	}
+	clusterIdKey := fmt.Sprintf("%s.0.%s", paramKafkaCluster, paramId)
+	environmentIdKey := fmt.Sprintf("%s.0.%s", paramEnvironment, paramId)
+	ingressByteRateKey := fmt.Sprintf("%s.0.%s", paramThroughput, paramIngressByteRate)
+	egressByteRateKey := fmt.Sprintf("%s.0.%s", paramThroughput, paramEgressByteRate)
+	for _, key := range []string{clusterIdKey, environmentIdKey, paramPrincipals, ingressByteRateKey, egressByteRateKey} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	c := meta.(*Client)
+	kafkaClientQuotaName := diff.Get(paramDisplayName).(string)
+	clusterId := diff.Get(clusterIdKey).(string)
+	environmentId := diff.Get(environmentIdKey).(string)
+
+	if diff.HasChange(paramPrincipals) {
+		kafkaClientQuotas, err := loadKafkaClientQuotas(ctx, c, environmentId, clusterId)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Skipping the check for principals of Kafka client quota %q that are already covered by another client quota: %s", kafkaClientQuotaName, createDescriptiveError(err)))
+		} else {
+			principals := convertToStringSlice(diff.Get(paramPrincipals).(*schema.Set).List())
+			if err := validateKafkaClientQuotaPrincipals(diff.Id(), principals, kafkaClientQuotas); err != nil {
+				return fmt.Errorf("error validating Kafka client quota %q: %s", kafkaClientQuotaName, err)
+			}
+		}
+	}
+
+	if diff.HasChange(paramThroughput) {
+		cluster, resp, err := executeKafkaRead(c.cmkV2ApiContext(ctx), c, environmentId, clusterId)
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Skipping the check for throughput limits of Kafka client quota %q: error reading Kafka Cluster %q: %s", kafkaClientQuotaName, clusterId, createDescriptiveError(err, resp)))
+			// Still check that the throughput values are numbers
+			cluster = cmkv2.CmkV2Cluster{}
+		}
+		if err := validateKafkaClientQuotaThroughput(cluster, diff.Get(ingressByteRateKey).(string), diff.Get(egressByteRateKey).(string)); err != nil {
+			return fmt.Errorf("error validating Kafka client quota %q: %s", kafkaClientQuotaName, err)
+		}
+	}
+
+	return nil
+}
+
+// validateKafkaClientQuotaPrincipals returns an error when any of the principals is already covered by a client quota other than kafkaClientQuotaId.
+func validateKafkaClientQuotaPrincipals(kafkaClientQuotaId string, principals []string, kafkaClientQuotas []kafkaquotasv1.KafkaQuotasV1ClientQuota) error {
+	coveringKafkaClientQuotas := make(map[string]string)
+	for _, kafkaClientQuota := range kafkaClientQuotas {
+		if kafkaClientQuota.GetId() == kafkaClientQuotaId {
+			continue
+		}
+		for _, principal := range extractKafkaClientQuotaPrincipals(kafkaClientQuota) {
+			coveringKafkaClientQuotas[principal] = fmt.Sprintf("%s (%s)", kafkaClientQuota.Spec.GetDisplayName(), kafkaClientQuota.GetId())
+		}
+	}
+	var conflicts []string
+	for _, principal := range principals {
+		if coveringKafkaClientQuota, ok := coveringKafkaClientQuotas[principal]; ok {
+			conflicts = append(conflicts, fmt.Sprintf("%q is already covered by Kafka client quota %s", principal, coveringKafkaClientQuota))
+		}
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+	sort.Strings(conflicts)
+	// The check can't see the changes of other client quotas in the same plan, and Terraform doesn't order
+	// the updates of independent resources either, so moving a principal takes two applies
+	return fmt.Errorf("a principal can only be covered by one client quota per Kafka cluster: %s. "+
+		"To move a principal to another client quota, remove it from its current client quota and apply the change first", strings.Join(conflicts, ", "))
+}
+
+// validateKafkaClientQuotaThroughput returns an error when the throughput exceeds the limits of the type of the Kafka cluster.
+// Enterprise and Freight clusters are only checked when their maximum eCKU is known.
+func validateKafkaClientQuotaThroughput(cluster cmkv2.CmkV2Cluster, ingressByteRate, egressByteRate string) error {
+	var maxIngressByteRate, maxEgressByteRate int64
+	config := cluster.Spec.GetConfig()
+	switch {
+	case config.CmkV2Basic != nil || config.CmkV2Standard != nil:
+		maxIngressByteRate, maxEgressByteRate = kafkaClientQuotaMaxIngressByteRate, kafkaClientQuotaMaxEgressByteRate
+	case config.CmkV2Dedicated != nil:
+		maxIngressByteRate, maxEgressByteRate = kafkaClientQuotaMaxByteRatesForCku(cluster.Status.GetCku())
+	case config.CmkV2Enterprise != nil:
+		maxIngressByteRate, maxEgressByteRate = kafkaClientQuotaMaxByteRatesForCku(config.CmkV2Enterprise.GetMaxEcku())
+	case config.CmkV2Freight != nil:
+		maxIngressByteRate, maxEgressByteRate = kafkaClientQuotaMaxByteRatesForCku(config.CmkV2Freight.GetMaxEcku())
+	}
+	if err := validateKafkaClientQuotaByteRate(paramIngressByteRate, ingressByteRate, maxIngressByteRate); err != nil {
+		return err
+	}
+	return validateKafkaClientQuotaByteRate(paramEgressByteRate, egressByteRate, maxEgressByteRate)
+}
+
+func kafkaClientQuotaMaxByteRatesForCku(cku int32) (int64, int64) {
+	return int64(cku) * kafkaClientQuotaMaxIngressByteRatePerCku, int64(cku) * kafkaClientQuotaMaxEgressByteRatePerCku
+}
+
+// validateKafkaClientQuotaByteRate skips the limit check when maxByteRate is unknown (0).
+func validateKafkaClientQuotaByteRate(name, byteRate string, maxByteRate int64) error {
+	byteRateValue, err := strconv.ParseInt(byteRate, 10, 64)
+	if err != nil || byteRateValue < 0 {
+		return fmt.Errorf("%q must be a non-negative number of bytes per second, got %q", name, byteRate)
+	}
+	if maxByteRate > 0 && byteRateValue > maxByteRate {
+		return fmt.Errorf("%q of %d bytes per second exceeds the limit of %d bytes per second of the Kafka cluster", name, byteRateValue, maxByteRate)
+	}
+	return nil
+}
+
 func kafkaClientQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	c := meta.(*Client)
 	createKafkaClientQuotaIdentifier := d.Get(paramDisplayName).(string)
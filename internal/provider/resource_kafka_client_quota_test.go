@@ -26,6 +26,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/walkerus/go-wiremock"
+
+	cmkv2 "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
+	kafkaquotasv1 "github.com/confluentinc/ccloud-sdk-go-v2/kafka-quotas/v1"
 )
 
 var kafkaClientQuotaPrincipals = []string{"sa-rv1vo7", "sa-jzgzgq"}
@@ -257,3 +260,137 @@ func formatListOfStringsForHcl(items []string) string {
 	}
 	return fmt.Sprintf("[%s]", strings.Join(itemsHcl[:], ","))
 }
+
+func TestValidateKafkaClientQuotaPrincipals(t *testing.T) {
+	newKafkaClientQuota := func(id string, principals ...string) kafkaquotasv1.KafkaQuotasV1ClientQuota {
+		principalsRefs := make([]kafkaquotasv1.GlobalObjectReference, len(principals))
+		for i, principal := range principals {
+			principalsRefs[i] = kafkaquotasv1.GlobalObjectReference{Id: principal}
+		}
+		spec := kafkaquotasv1.NewKafkaQuotasV1ClientQuotaSpecWithDefaults()
+		spec.SetDisplayName(id)
+		spec.SetPrincipals(principalsRefs)
+		return kafkaquotasv1.KafkaQuotasV1ClientQuota{Id: &id, Spec: spec}
+	}
+	kafkaClientQuotas := []kafkaquotasv1.KafkaQuotasV1ClientQuota{
+		newKafkaClientQuota(kafkaClientQuotaId, kafkaClientQuotaPrincipals...),
+		newKafkaClientQuota("cq-a1b2c", "<default>"),
+	}
+
+	tests := []struct {
+		name               string
+		kafkaClientQuotaId string
+		principals         []string
+		expectError        bool
+	}{
+		{
+			name:       "new principals",
+			principals: []string{"sa-abc123"},
+		},
+		{
+			name:        "principal covered by another client quota",
+			principals:  []string{"sa-abc123", kafkaClientQuotaPrincipals[0]},
+			expectError: true,
+		},
+		{
+			name:        "default principal covered by another client quota",
+			principals:  []string{"<default>"},
+			expectError: true,
+		},
+		{
+			name:               "principals covered by the same client quota",
+			kafkaClientQuotaId: kafkaClientQuotaId,
+			principals:         kafkaClientQuotaPrincipals,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateKafkaClientQuotaPrincipals(tt.kafkaClientQuotaId, tt.principals, kafkaClientQuotas)
+			if tt.expectError && err == nil {
+				t.Fatalf("expected an error for principals %v", tt.principals)
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestValidateKafkaClientQuotaThroughput(t *testing.T) {
+	cku := int32(2)
+	maxEcku := int32(4)
+	standardCluster := cmkv2.CmkV2Cluster{Spec: &cmkv2.CmkV2ClusterSpec{Config: &cmkv2.CmkV2ClusterSpecConfigOneOf{CmkV2Standard: cmkv2.NewCmkV2Standard(kafkaClusterTypeStandard)}}}
+	dedicatedCluster := cmkv2.CmkV2Cluster{
+		Spec:   &cmkv2.CmkV2ClusterSpec{Config: &cmkv2.CmkV2ClusterSpecConfigOneOf{CmkV2Dedicated: cmkv2.NewCmkV2Dedicated(kafkaClusterTypeDedicated, cku)}},
+		Status: &cmkv2.CmkV2ClusterStatus{Cku: &cku},
+	}
+	enterpriseCluster := cmkv2.CmkV2Cluster{Spec: &cmkv2.CmkV2ClusterSpec{Config: &cmkv2.CmkV2ClusterSpecConfigOneOf{CmkV2Enterprise: &cmkv2.CmkV2Enterprise{Kind: kafkaClusterTypeEnterprise, MaxEcku: &maxEcku}}}}
+
+	tests := []struct {
+		name            string
+		cluster         cmkv2.CmkV2Cluster
+		ingressByteRate string
+		egressByteRate  string
+		expectError     bool
+	}{
+		{
+			name:            "within the limits of a Standard cluster",
+			cluster:         standardCluster,
+			ingressByteRate: "250000000",
+			egressByteRate:  "750000000",
+		},
+		{
+			name:            "above the ingress limit of a Standard cluster",
+			cluster:         standardCluster,
+			ingressByteRate: "250000001",
+			egressByteRate:  "1",
+			expectError:     true,
+		},
+		{
+			name:            "within the limits of a 2 CKU Dedicated cluster",
+			cluster:         dedicatedCluster,
+			ingressByteRate: "120000000",
+			egressByteRate:  "360000000",
+		},
+		{
+			name:            "above the egress limit of a 2 CKU Dedicated cluster",
+			cluster:         dedicatedCluster,
+			ingressByteRate: "1",
+			egressByteRate:  "360000001",
+			expectError:     true,
+		},
+		{
+			name:            "above the ingress limit of an Enterprise cluster with 4 max eCKU",
+			cluster:         enterpriseCluster,
+			ingressByteRate: "240000001",
+			egressByteRate:  "1",
+			expectError:     true,
+		},
+		{
+			name:            "unknown cluster type",
+			cluster:         cmkv2.CmkV2Cluster{},
+			ingressByteRate: "999999999999",
+			egressByteRate:  "999999999999",
+		},
+		{
+			name:            "not a number",
+			cluster:         cmkv2.CmkV2Cluster{},
+			ingressByteRate: "10MB",
+			egressByteRate:  "1",
+			expectError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateKafkaClientQuotaThroughput(tt.cluster, tt.ingressByteRate, tt.egressByteRate)
+			if tt.expectError && err == nil {
+				t.Fatalf("expected an error for ingress %q and egress %q", tt.ingressByteRate, tt.egressByteRate)
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
@@ -22,12 +22,15 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/sync/singleflight"
 
 	cmkv2 "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
 )
@@ -36,6 +39,7 @@ var acceptedAvailabilityZones = []string{singleZone, multiZone, lowAvailability,
 var acceptedCloudProviders = []string{"AWS", "AZURE", "GCP"}
 var acceptedClusterTypes = []string{paramBasicCluster, paramStandardCluster, paramDedicatedCluster, paramEnterpriseCluster, paramFreightCluster}
 var paramDedicatedCku = fmt.Sprintf("%s.0.%s", paramDedicatedCluster, paramCku)
+var paramDedicatedZones = fmt.Sprintf("%s.0.%s", paramDedicatedCluster, paramZones)
 var paramDedicatedEncryptionKey = fmt.Sprintf("%s.0.%s", paramDedicatedCluster, paramEncryptionKey)
 var paramBasicMaxEcku = fmt.Sprintf("%s.0.%s", paramBasicCluster, paramMaxEcku)
 var paramStandardMaxEcku = fmt.Sprintf("%s.0.%s", paramStandardCluster, paramMaxEcku)
@@ -123,6 +127,12 @@ func kafkaResource() *schema.Resource {
 				Optional:    true,
 				Description: "Enable deletion protection for the Kafka cluster.",
 			},
+			paramMaxCkuStep: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The maximum number of CKUs that a Dedicated Kafka cluster is expanded or shrunk by at a time. Larger CKU updates are performed as multiple sequential steps.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			paramEnvironment:          environmentSchema(),
 			paramConfluentCustomerKey: byokSchema(),
 			paramEndpoints: {
@@ -151,8 +161,32 @@ func kafkaResource() *schema.Resource {
 				Computed:    true,
 				Description: "A map of endpoints for connecting to the Kafka cluster, keyed by access_point_id. Access Point ID 'public' and 'privatelink' are reserved. These can be used for different network access methods or regions.",
 			},
+			paramPreferredConnectionType: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{paramAccessPointID},
+				ValidateFunc:  validation.StringIsNotEmpty,
+				Description:   "The connection type of the endpoints to select, for example, `PUBLIC` or `PRIVATE_LINK`.",
+			},
+			paramAccessPointID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{paramPreferredConnectionType},
+				ValidateFunc:  validation.StringIsNotEmpty,
+				Description:   "The ID of the access point of the endpoints to select.",
+			},
+			paramSelectedBootstrapEndpoint: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The bootstrap endpoint selected by `preferred_connection_type` or `access_point_id`.",
+			},
+			paramSelectedRestEndpoint: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The REST endpoint selected by `preferred_connection_type` or `access_point_id`.",
+			},
 		},
-		CustomizeDiff: customdiff.Sequence(resourceKafkaCustomizeDiff),
+		CustomizeDiff: customdiff.Sequence(resourceKafkaCustomizeDiff, resourceKafkaCkuCustomizeDiff, resourceKafkaSelectedEndpointsCustomizeDiff),
 		Timeouts: &schema.ResourceTimeout{
 			// https://docs.confluent.io/cloud/current/clusters/cluster-types.html#provisioning-time
 			Create: schema.DefaultTimeout(getTimeoutFor(kafkaClusterTypeDedicated)),
@@ -189,6 +223,38 @@ func resourceKafkaCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff,
 	return nil
 }
 
+// resourceKafkaCkuCustomizeDiff validates the CKU of Dedicated Kafka clusters against their availability and zones
+// during `terraform plan` rather than after a possibly long-running update has been requested.
+func resourceKafkaCkuCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChange(paramDedicatedCku) || extractClusterTypeResourceDiff(diff) != kafkaClusterTypeDedicated {
+		return nil
+	}
+	if !diff.NewValueKnown(paramDedicatedCku) || !diff.NewValueKnown(paramAvailability) {
+		return nil
+	}
+	cku := int32(diff.Get(paramDedicatedCku).(int))
+	if err := ckuCheck(cku, diff.Get(paramAvailability).(string)); err != nil {
+		return fmt.Errorf("error validating Kafka Cluster %q: %s", diff.Get(paramDisplayName), createDescriptiveError(err))
+	}
+	// Zones are only known for existing Kafka clusters
+	if err := ckuZonesCheck(cku, len(diff.Get(paramDedicatedZones).([]interface{}))); err != nil {
+		return fmt.Errorf("error validating Kafka Cluster %q: %s", diff.Get(paramDisplayName), createDescriptiveError(err))
+	}
+	return nil
+}
+
+// resourceKafkaSelectedEndpointsCustomizeDiff marks the selected endpoints as known after apply when the endpoint selector changes,
+// since they're only resolved when the Kafka cluster is read.
+func resourceKafkaSelectedEndpointsCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChanges(paramPreferredConnectionType, paramAccessPointID) {
+		return nil
+	}
+	if err := diff.SetNewComputed(paramSelectedBootstrapEndpoint); err != nil {
+		return err
+	}
+	return diff.SetNewComputed(paramSelectedRestEndpoint)
+}
+
 func createMaxEckuUpdateSpec(d *schema.ResourceData, clusterType string, isBasic, isStandard, isEnterprise, isFreight bool) *cmkv2.CmkV2ClusterSpecUpdate {
 	updateSpec := cmkv2.NewCmkV2ClusterSpecUpdate()
 
@@ -325,16 +391,32 @@ func kafkaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 			return diag.FromErr(createDescriptiveError(err))
 		}
 
-		updateSpec := cmkv2.NewCmkV2ClusterSpecUpdate()
-		updateSpec.SetConfig(cmkv2.CmkV2DedicatedAsCmkV2ClusterSpecUpdateConfigOneOf(cmkv2.NewCmkV2Dedicated(kafkaClusterTypeDedicated, cku)))
-		updateSpec.SetEnvironment(cmkv2.EnvScopedObjectReference{Id: environmentId})
-
-		if err := executeClusterUpdate(ctx, c, d.Id(), updateSpec, "CKU"); err != nil {
-			return err
-		}
-
-		if err := waitForKafkaClusterCkuUpdateToComplete(c.cmkV2ApiContext(ctx), c, environmentId, d.Id(), cku); err != nil {
-			return diag.Errorf("error waiting for Kafka Cluster %q to perform CKU update: %s", d.Id(), createDescriptiveError(err))
+		oldCku, _ := d.GetChange(paramDedicatedCku)
+		currentCku := int32(oldCku.(int))
+		steps := planCkuUpdateSteps(currentCku, cku, int32(d.Get(paramMaxCkuStep).(int)))
+		// The update timeout covers all steps, so each step waits for an equal share of the time that is left
+		updateDeadline := time.Now().Add(d.Timeout(schema.TimeoutUpdate))
+		for i, stepCku := range steps {
+			if len(steps) > 1 {
+				tflog.Info(ctx, fmt.Sprintf("Updating Kafka Cluster %q to %d CKUs: step %d of %d", d.Id(), stepCku, i+1, len(steps)), map[string]interface{}{kafkaClusterLoggingKey: d.Id()})
+			}
+
+			updateSpec := cmkv2.NewCmkV2ClusterSpecUpdate()
+			updateSpec.SetConfig(cmkv2.CmkV2DedicatedAsCmkV2ClusterSpecUpdateConfigOneOf(cmkv2.NewCmkV2Dedicated(kafkaClusterTypeDedicated, stepCku)))
+			updateSpec.SetEnvironment(cmkv2.EnvScopedObjectReference{Id: environmentId})
+
+			if err := executeClusterUpdate(ctx, c, d.Id(), updateSpec, "CKU"); err != nil {
+				return err
+			}
+
+			stepTimeout := time.Until(updateDeadline) / time.Duration(len(steps)-i)
+			if err := waitForKafkaClusterCkuUpdateToComplete(c.cmkV2ApiContext(ctx), c, environmentId, d.Id(), stepCku, stepTimeout); err != nil {
+				return diag.Errorf("error waiting for Kafka Cluster %q to perform CKU update: %s", d.Id(), createDescriptiveError(err))
+			}
+			if len(steps) > 1 {
+				progress := int64(stepCku-currentCku) * 100 / int64(cku-currentCku)
+				tflog.Info(ctx, fmt.Sprintf("Updated Kafka Cluster %q to %d CKUs: step %d of %d is done, %d%% of the CKU update is complete", d.Id(), stepCku, i+1, len(steps), progress), map[string]interface{}{kafkaClusterLoggingKey: d.Id()})
+			}
 		}
 	}
 
@@ -501,6 +583,23 @@ func extractClusterType(d *schema.ResourceData) string {
 	return ""
 }
 
+// kafkaClusterTypeOf returns the type of the Kafka cluster, for example, "Dedicated".
+func kafkaClusterTypeOf(cluster cmkv2.CmkV2Cluster) string {
+	config := cluster.Spec.GetConfig()
+	if config.CmkV2Basic != nil {
+		return kafkaClusterTypeBasic
+	} else if config.CmkV2Standard != nil {
+		return kafkaClusterTypeStandard
+	} else if config.CmkV2Dedicated != nil {
+		return kafkaClusterTypeDedicated
+	} else if config.CmkV2Enterprise != nil {
+		return kafkaClusterTypeEnterprise
+	} else if config.CmkV2Freight != nil {
+		return kafkaClusterTypeFreight
+	}
+	return ""
+}
+
 func extractClusterTypeResourceDiff(d *schema.ResourceDiff) string {
 	basicConfigBlock := d.Get(paramBasicCluster).([]interface{})
 	standardConfigBlock := d.Get(paramStandardCluster).([]interface{})
@@ -788,14 +887,40 @@ func byokSchema() *schema.Schema {
 }
 
 func ckuCheck(cku int32, availability string) error {
-	if cku < 1 && availability == singleZone {
+	if cku < 1 && (availability == singleZone || availability == lowAvailability) {
 		return fmt.Errorf("single-zone dedicated clusters must have at least 1 CKU")
-	} else if cku < 2 && availability == multiZone {
+	} else if cku < 2 && (availability == multiZone || availability == highAvailability) {
 		return fmt.Errorf("multi-zone dedicated clusters must have at least 2 CKUs")
 	}
 	return nil
 }
 
+// ckuZonesCheck validates the CKU of a Dedicated Kafka cluster against the number of zones it's in.
+func ckuZonesCheck(cku int32, zoneCount int) error {
+	if zoneCount > 1 && cku < 2 {
+		return fmt.Errorf("dedicated clusters in %d zones must have at least 2 CKUs", zoneCount)
+	}
+	return nil
+}
+
+// planCkuUpdateSteps returns the CKU counts that a Dedicated Kafka cluster is resized to one after another,
+// so that each step changes its CKU count by at most maxCkuStep. A non-positive maxCkuStep means a single step.
+func planCkuUpdateSteps(currentCku, desiredCku, maxCkuStep int32) []int32 {
+	if maxCkuStep <= 0 || currentCku <= 0 {
+		return []int32{desiredCku}
+	}
+	var steps []int32
+	for currentCku != desiredCku {
+		if desiredCku > currentCku {
+			currentCku = min(currentCku+maxCkuStep, desiredCku)
+		} else {
+			currentCku = max(currentCku-maxCkuStep, desiredCku)
+		}
+		steps = append(steps, currentCku)
+	}
+	return steps
+}
+
 func setKafkaClusterAttributes(d *schema.ResourceData, cluster cmkv2.CmkV2Cluster) (*schema.ResourceData, error) {
 	if err := d.Set(paramApiVersion, cluster.GetApiVersion()); err != nil {
 		return nil, err
@@ -897,6 +1022,16 @@ func setKafkaClusterAttributes(d *schema.ResourceData, cluster cmkv2.CmkV2Cluste
 	if err := d.Set(paramEndpoints, constructEndpointsBlockValue(cluster.Spec.GetEndpoints())); err != nil {
 		return nil, err
 	}
+	selectedBootstrapEndpoint, selectedRestEndpoint, err := selectKafkaClusterEndpoints(cluster, d.Get(paramAccessPointID).(string), d.Get(paramPreferredConnectionType).(string), false)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramSelectedBootstrapEndpoint, selectedBootstrapEndpoint); err != nil {
+		return nil, err
+	}
+	if err := d.Set(paramSelectedRestEndpoint, selectedRestEndpoint); err != nil {
+		return nil, err
+	}
 	d.SetId(cluster.GetId())
 	return d, nil
 }
@@ -925,6 +1060,134 @@ func constructEndpointsBlockValue(modelMap cmkv2.ModelMap) []interface{} {
 	return endpointsList
 }
 
+// selectKafkaClusterEndpoints returns the bootstrap and REST endpoints of the access point with accessPointId if it's set,
+// or else of the first access point (by ID) whose connection type matches connectionType.
+// When no access point matches connectionType, it returns the default endpoints of the Kafka cluster unless isConnectionTypeStrict is set.
+func selectKafkaClusterEndpoints(cluster cmkv2.CmkV2Cluster, accessPointId, connectionType string, isConnectionTypeStrict bool) (string, string, error) {
+	endpoints := cluster.Spec.GetEndpoints()
+	accessPointIds := make([]string, 0, len(endpoints))
+	for id := range endpoints {
+		accessPointIds = append(accessPointIds, id)
+	}
+	sort.Strings(accessPointIds)
+
+	if accessPointId != "" {
+		endpoint, ok := endpoints[accessPointId]
+		if !ok {
+			return "", "", fmt.Errorf("Kafka Cluster %q has no access point %q, available access points: %s", cluster.GetId(), accessPointId, strings.Join(accessPointIds, ", "))
+		}
+		return endpoint.GetKafkaBootstrapEndpoint(), endpoint.GetHttpEndpoint(), nil
+	}
+	if connectionType != "" {
+		for _, id := range accessPointIds {
+			endpoint := endpoints[id]
+			if strings.EqualFold(endpoint.GetConnectionType(), connectionType) {
+				return endpoint.GetKafkaBootstrapEndpoint(), endpoint.GetHttpEndpoint(), nil
+			}
+		}
+		if isConnectionTypeStrict {
+			return "", "", fmt.Errorf("Kafka Cluster %q has no endpoints with connection type %q", cluster.GetId(), connectionType)
+		}
+	}
+	return cluster.Spec.GetKafkaBootstrapEndpoint(), cluster.Spec.GetHttpEndpoint(), nil
+}
+
+// kafkaClusterCacheFillKey is the key of the single listing of Kafka clusters that concurrent cache misses share.
+const kafkaClusterCacheFillKey = "fill"
+
+// kafkaClusterCache caches the Kafka clusters that are looked up by ID for the duration of a Terraform run.
+// Concurrent lookups share a single listing of the Kafka clusters, whichever Kafka cluster they look up.
+type kafkaClusterCache struct {
+	mu       sync.RWMutex
+	clusters map[string]cmkv2.CmkV2Cluster
+	group    singleflight.Group
+}
+
+func newKafkaClusterCache() *kafkaClusterCache {
+	return &kafkaClusterCache{clusters: make(map[string]cmkv2.CmkV2Cluster)}
+}
+
+// get returns the Kafka cluster with clusterId. On a cache miss, it lists the Kafka clusters in all environments
+// and caches all of them, since Kafka cluster IDs are unique across environments.
+func (cache *kafkaClusterCache) get(ctx context.Context, c *Client, clusterId string) (cmkv2.CmkV2Cluster, error) {
+	if cluster, ok := cache.load(clusterId); ok {
+		return cluster, nil
+	}
+	clusters, err, _ := cache.group.Do(kafkaClusterCacheFillKey, func() (interface{}, error) {
+		return cache.fill(ctx, c)
+	})
+	if err != nil {
+		return cmkv2.CmkV2Cluster{}, err
+	}
+	// The listed Kafka clusters include the ones that aren't cached because they don't have a REST endpoint yet
+	for _, cluster := range clusters.([]cmkv2.CmkV2Cluster) {
+		if cluster.GetId() == clusterId {
+			return cluster, nil
+		}
+	}
+	return cmkv2.CmkV2Cluster{}, fmt.Errorf("Kafka Cluster %q was not found in any environment", clusterId)
+}
+
+func (cache *kafkaClusterCache) load(clusterId string) (cmkv2.CmkV2Cluster, bool) {
+	cache.mu.RLock()
+	defer cache.mu.RUnlock()
+	cluster, ok := cache.clusters[clusterId]
+	return cluster, ok
+}
+
+// store caches the Kafka clusters that have a REST endpoint. Kafka clusters that are still being provisioned
+// don't have one yet, so they are listed again on the next lookup.
+func (cache *kafkaClusterCache) store(clusters []cmkv2.CmkV2Cluster) {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	for _, cluster := range clusters {
+		if cluster.Spec.GetHttpEndpoint() != "" {
+			cache.clusters[cluster.GetId()] = cluster
+		}
+	}
+}
+
+// fill lists the Kafka clusters in all environments and caches them.
+func (cache *kafkaClusterCache) fill(ctx context.Context, c *Client) ([]cmkv2.CmkV2Cluster, error) {
+	environments, err := loadEnvironments(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	var allClusters []cmkv2.CmkV2Cluster
+	for _, environment := range environments {
+		clusters, err := loadKafkaClusters(ctx, c, environment.GetId())
+		if err != nil {
+			return nil, err
+		}
+		allClusters = append(allClusters, clusters...)
+	}
+	cache.store(allClusters)
+	return allClusters, nil
+}
+
+// lookupKafkaClusterRestEndpoint returns the REST endpoint of the Kafka cluster with clusterId that has connectionType.
+// When connectionType isn't set, provider.kafka_preferred_connection_type is preferred instead,
+// falling back to the default REST endpoint of the Kafka cluster.
+func lookupKafkaClusterRestEndpoint(ctx context.Context, c *Client, clusterId, connectionType string) (string, error) {
+	isConnectionTypeStrict := connectionType != ""
+	if !isConnectionTypeStrict {
+		connectionType = c.kafkaPreferredConnectionType
+	}
+	cluster, err := c.kafkaClusterCache.get(ctx, c, clusterId)
+	if err != nil {
+		return "", fmt.Errorf("error looking up the REST endpoint of Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
+	}
+	_, restEndpoint, err := selectKafkaClusterEndpoints(cluster, "", connectionType, isConnectionTypeStrict)
+	if err != nil {
+		return "", fmt.Errorf("error looking up the REST endpoint of Kafka Cluster %q: %s", clusterId, createDescriptiveError(err))
+	}
+	if restEndpoint == "" {
+		return "", fmt.Errorf("error looking up the REST endpoint of Kafka Cluster %q: the Kafka Cluster has no REST endpoint yet", clusterId)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Looked up REST endpoint %q of Kafka Cluster %q", restEndpoint, clusterId), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+	return restEndpoint, nil
+}
+
 func optionalNetworkSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeList,
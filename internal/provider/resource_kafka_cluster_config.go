@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -63,18 +64,62 @@ func kafkaConfigResource() *schema.Resource {
 			paramRestEndpoint: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
+				Computed:     true,
 				Description:  "The REST endpoint of the Kafka cluster (e.g., `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
 				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
 			},
-			paramCredentials: credentialsSchema(),
+			paramRestEndpointConnectionType: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{paramRestEndpoint},
+				ValidateFunc:  validation.StringIsNotEmpty,
+				Description:   "The connection type of the REST endpoint to look up for the Kafka cluster when `rest_endpoint` isn't set, for example, `PRIVATE_LINK`.",
+			},
+			paramCredentials:     credentialsSchema(),
+			paramEffectiveConfig: effectiveConfigSchema(),
 		},
-		CustomizeDiff: customdiff.Sequence(resourceCredentialBlockValidationWithOAuth),
+		CustomizeDiff: customdiff.Sequence(resourceCredentialBlockValidationWithOAuth, restEndpointConnectionTypeCustomizeDiff),
+	}
+}
+
+func effectiveConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramName: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				paramValue: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				paramSource: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				paramIsDefault: {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				paramIsReadOnly: {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				paramIsSensitive: {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+			},
+		},
+		Description: "All cluster settings, including the ones that are not set in the `config` block, sorted by name.",
 	}
 }
 
 func kafkaConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	restEndpoint, err := extractRestEndpointOrLookUp(ctx, meta.(*Client), d, false)
 	if err != nil {
 		return diag.Errorf("error creating Kafka Config: %s", createDescriptiveError(err))
 	}
@@ -132,7 +177,7 @@ func kafkaConfigDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 func kafkaConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Config %q", d.Id()), map[string]interface{}{kafkaClusterLoggingKey: d.Id()})
 
-	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	restEndpoint, err := extractRestEndpointOrLookUp(ctx, meta.(*Client), d, false)
 	if err != nil {
 		return diag.Errorf("error reading Kafka Config: %s", createDescriptiveError(err))
 	}
@@ -208,6 +253,9 @@ func readConfigAndSetAttributes(ctx context.Context, d *schema.ResourceData, c *
 	if err := d.Set(paramConfigs, convertKafkaConfigToMap(kafkaConfig)); err != nil {
 		return nil, err
 	}
+	if err := d.Set(paramEffectiveConfig, convertKafkaConfigToEffectiveConfig(kafkaConfig)); err != nil {
+		return nil, err
+	}
 
 	if !c.isClusterIdSetInProviderBlock {
 		if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, c.clusterId, d); err != nil {
@@ -230,8 +278,8 @@ func readConfigAndSetAttributes(ctx context.Context, d *schema.ResourceData, c *
 }
 
 func kafkaConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials, paramConfigs) {
-		return diag.Errorf("error updating Kafka Config %q: only %q and %q blocks can be updated for Kafka Config", d.Id(), paramCredentials, paramConfigs)
+	if d.HasChangesExcept(paramCredentials, paramConfigs, paramRestEndpoint, paramRestEndpointConnectionType) {
+		return diag.Errorf("error updating Kafka Config %q: only %q, %q, %q and %q blocks can be updated for Kafka Config", d.Id(), paramCredentials, paramConfigs, paramRestEndpoint, paramRestEndpointConnectionType)
 	}
 	if d.HasChange(paramConfigs) {
 		// TF Provider allows the following operations for editable cluster settings under 'config' block:
@@ -260,7 +308,7 @@ func kafkaConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 		updateConfigRequest := kafkarestv3.AlterConfigBatchRequestData{
 			Data: extractClusterConfigs(newSettingsMapAny.(map[string]interface{})),
 		}
-		restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+		restEndpoint, err := extractRestEndpointOrLookUp(ctx, meta.(*Client), d, false)
 		if err != nil {
 			return diag.Errorf("error updating Kafka Config: %s", createDescriptiveError(err))
 		}
@@ -306,6 +354,32 @@ func convertKafkaConfigToMap(clusterConfigList kafkarestv3.ClusterConfigDataList
 	return config
 }
 
+func convertKafkaConfigToEffectiveConfig(clusterConfigList kafkarestv3.ClusterConfigDataList) []interface{} {
+	remoteConfigs := make([]kafkarestv3.ClusterConfigData, len(clusterConfigList.Data))
+	copy(remoteConfigs, clusterConfigList.Data)
+	sort.Slice(remoteConfigs, func(i, j int) bool {
+		return remoteConfigs[i].Name < remoteConfigs[j].Name
+	})
+
+	effectiveConfig := make([]interface{}, len(remoteConfigs))
+	for i, remoteConfig := range remoteConfigs {
+		// The value of a sensitive cluster setting is always null
+		value := ""
+		if remoteConfig.Value.IsSet() && remoteConfig.Value.Get() != nil {
+			value = *remoteConfig.Value.Get()
+		}
+		effectiveConfig[i] = map[string]interface{}{
+			paramName:        remoteConfig.Name,
+			paramValue:       value,
+			paramSource:      remoteConfig.Source,
+			paramIsDefault:   remoteConfig.IsDefault,
+			paramIsReadOnly:  remoteConfig.IsReadOnly,
+			paramIsSensitive: remoteConfig.IsSensitive,
+		}
+	}
+	return effectiveConfig
+}
+
 func extractClusterConfigs(configs map[string]interface{}) []kafkarestv3.AlterConfigBatchRequestDataData {
 	configResult := make([]kafkarestv3.AlterConfigBatchRequestDataData, len(configs))
 
@@ -98,7 +98,7 @@ func TestAccClusterConfigWithEnhancedProviderBlock(t *testing.T) {
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "kafka_cluster.#", "1"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "kafka_cluster.0.id", clusterId),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "id", fmt.Sprintf("%s", clusterId)),
-					resource.TestCheckResourceAttr(fullConfigResourceLabel, "%", "5"),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "%", "6"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "config.%", "3"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, fmt.Sprintf("config.%s", firstClusterConfigName), firstClusterConfigValue),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, fmt.Sprintf("config.%s", secondClusterConfigName), secondClusterConfigValue),
@@ -113,7 +113,7 @@ func TestAccClusterConfigWithEnhancedProviderBlock(t *testing.T) {
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "kafka_cluster.#", "1"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "kafka_cluster.0.id", clusterId),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "id", fmt.Sprintf("%s", clusterId)),
-					resource.TestCheckResourceAttr(fullConfigResourceLabel, "%", "5"),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "%", "6"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "config.%", "6"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, fmt.Sprintf("config.%s", firstClusterConfigName), firstClusterConfigUpdatedValue),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, fmt.Sprintf("config.%s", secondClusterConfigName), secondClusterConfigValue),
@@ -114,12 +114,24 @@ func TestAccClusterConfig(t *testing.T) {
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "kafka_cluster.#", "1"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "kafka_cluster.0.id", clusterId),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "id", fmt.Sprintf("%s", clusterId)),
-					resource.TestCheckResourceAttr(fullConfigResourceLabel, "%", "5"),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "%", "6"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "rest_endpoint", mockConfigTestServerUrl),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "config.%", "3"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, fmt.Sprintf("config.%s", firstClusterConfigName), firstClusterConfigValue),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, fmt.Sprintf("config.%s", secondClusterConfigName), secondClusterConfigValue),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, fmt.Sprintf("config.%s", thirdClusterConfigName), thirdClusterConfigValue),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.#", "3"),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.0.name", firstClusterConfigName),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.0.value", firstClusterConfigValue),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.0.source", "DYNAMIC_DEFAULT_BROKER_CONFIG"),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.0.is_default", "false"),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.0.is_read_only", "false"),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.0.is_sensitive", "false"),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.1.name", thirdClusterConfigName),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.1.value", thirdClusterConfigValue),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.2.name", secondClusterConfigName),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.2.value", secondClusterConfigValue),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "effective_config.2.is_read_only", "true"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "credentials.#", "1"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "credentials.0.%", "2"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "credentials.0.key", kafkaApiKey),
@@ -133,7 +145,7 @@ func TestAccClusterConfig(t *testing.T) {
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "kafka_cluster.#", "1"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "kafka_cluster.0.id", clusterId),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "id", fmt.Sprintf("%s", clusterId)),
-					resource.TestCheckResourceAttr(fullConfigResourceLabel, "%", "5"),
+					resource.TestCheckResourceAttr(fullConfigResourceLabel, "%", "6"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "rest_endpoint", mockConfigTestServerUrl),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, "config.%", "6"),
 					resource.TestCheckResourceAttr(fullConfigResourceLabel, fmt.Sprintf("config.%s", firstClusterConfigName), firstClusterConfigUpdatedValue),
@@ -0,0 +1,119 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+
+	cmkv2 "github.com/confluentinc/ccloud-sdk-go-v2/cmk/v2"
+)
+
+func TestSelectKafkaClusterEndpoints(t *testing.T) {
+	endpoints := cmkv2.ModelMap{
+		"ap2-privatelink": {KafkaBootstrapEndpoint: "lkc-abc123-ap2.us-east-1.aws.private.confluent.cloud:9092", HttpEndpoint: "https://lkc-abc123-ap2.us-east-1.aws.private.confluent.cloud:443", ConnectionType: "PRIVATE_LINK"},
+		"ap1-privatelink": {KafkaBootstrapEndpoint: "lkc-abc123-ap1.us-east-1.aws.private.confluent.cloud:9092", HttpEndpoint: "https://lkc-abc123-ap1.us-east-1.aws.private.confluent.cloud:443", ConnectionType: "PRIVATE_LINK"},
+		"public":          {KafkaBootstrapEndpoint: "pkc-00000.us-east-1.aws.confluent.cloud:9092", HttpEndpoint: "https://pkc-00000.us-east-1.aws.confluent.cloud:443", ConnectionType: "PUBLIC"},
+	}
+	cluster := cmkv2.CmkV2Cluster{
+		Id: ptr("lkc-abc123"),
+		Spec: &cmkv2.CmkV2ClusterSpec{
+			KafkaBootstrapEndpoint: ptr("SASL_SSL://pkc-00000.us-east-1.aws.confluent.cloud:9092"),
+			HttpEndpoint:           ptr("https://pkc-00000.us-east-1.aws.confluent.cloud:443"),
+			Endpoints:              &endpoints,
+		},
+	}
+
+	tests := []struct {
+		name                      string
+		accessPointId             string
+		connectionType            string
+		isConnectionTypeStrict    bool
+		expectedBootstrapEndpoint string
+		expectedRestEndpoint      string
+		expectError               bool
+	}{
+		{
+			name:                      "no selector",
+			expectedBootstrapEndpoint: "SASL_SSL://pkc-00000.us-east-1.aws.confluent.cloud:9092",
+			expectedRestEndpoint:      "https://pkc-00000.us-east-1.aws.confluent.cloud:443",
+		},
+		{
+			name:                      "access point",
+			accessPointId:             "ap2-privatelink",
+			expectedBootstrapEndpoint: "lkc-abc123-ap2.us-east-1.aws.private.confluent.cloud:9092",
+			expectedRestEndpoint:      "https://lkc-abc123-ap2.us-east-1.aws.private.confluent.cloud:443",
+		},
+		{
+			name:          "missing access point",
+			accessPointId: "ap3-privatelink",
+			expectError:   true,
+		},
+		{
+			name:                      "connection type picks the first access point by ID",
+			connectionType:            "private_link",
+			expectedBootstrapEndpoint: "lkc-abc123-ap1.us-east-1.aws.private.confluent.cloud:9092",
+			expectedRestEndpoint:      "https://lkc-abc123-ap1.us-east-1.aws.private.confluent.cloud:443",
+		},
+		{
+			name:                      "unmatched connection type falls back to the default endpoints",
+			connectionType:            "PRIVATE_NETWORK_INTERFACE",
+			expectedBootstrapEndpoint: "SASL_SSL://pkc-00000.us-east-1.aws.confluent.cloud:9092",
+			expectedRestEndpoint:      "https://pkc-00000.us-east-1.aws.confluent.cloud:443",
+		},
+		{
+			name:                   "unmatched strict connection type",
+			connectionType:         "PRIVATE_NETWORK_INTERFACE",
+			isConnectionTypeStrict: true,
+			expectError:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bootstrapEndpoint, restEndpoint, err := selectKafkaClusterEndpoints(cluster, tt.accessPointId, tt.connectionType, tt.isConnectionTypeStrict)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected an error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if bootstrapEndpoint != tt.expectedBootstrapEndpoint {
+				t.Errorf("expected bootstrap endpoint %q, got %q", tt.expectedBootstrapEndpoint, bootstrapEndpoint)
+			}
+			if restEndpoint != tt.expectedRestEndpoint {
+				t.Errorf("expected REST endpoint %q, got %q", tt.expectedRestEndpoint, restEndpoint)
+			}
+		})
+	}
+}
+
+func TestKafkaClusterCacheStore(t *testing.T) {
+	cache := newKafkaClusterCache()
+	cache.store([]cmkv2.CmkV2Cluster{
+		{Id: ptr("lkc-abc123"), Spec: &cmkv2.CmkV2ClusterSpec{HttpEndpoint: ptr("https://pkc-00000.us-east-1.aws.confluent.cloud:443")}},
+		// The Kafka cluster is still being provisioned
+		{Id: ptr("lkc-def456"), Spec: &cmkv2.CmkV2ClusterSpec{HttpEndpoint: ptr("")}},
+	})
+
+	if _, ok := cache.load("lkc-abc123"); !ok {
+		t.Errorf("expected Kafka cluster %q to be cached", "lkc-abc123")
+	}
+	if _, ok := cache.load("lkc-def456"); ok {
+		t.Errorf("expected Kafka cluster %q without a REST endpoint not to be cached", "lkc-def456")
+	}
+}
@@ -82,7 +82,7 @@ func kafkaMirrorTopicResource() *schema.Resource {
 }
 
 func kafkaMirrorTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(d, meta)
+	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(ctx, d, meta)
 	if err != nil {
 		return diag.Errorf("error creating Kafka Mirror Topic: %s", createDescriptiveError(err))
 	}
@@ -121,7 +121,7 @@ func kafkaMirrorTopicCreate(ctx context.Context, d *schema.ResourceData, meta in
 func kafkaMirrorTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Mirror Topic %q", d.Id()), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
 
-	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(d, meta)
+	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(ctx, d, meta)
 	if err != nil {
 		return diag.Errorf("error creating Kafka Mirror Topic: %s", createDescriptiveError(err))
 	}
@@ -198,7 +198,7 @@ func setKafkaMirrorTopicAttributes(d *schema.ResourceData, c *KafkaRestClient, k
 func kafkaMirrorTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Kafka Mirror Topic %q", d.Id()), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
 
-	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(d, meta)
+	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(ctx, d, meta)
 	if err != nil {
 		return diag.Errorf("error creating Kafka Mirror Topic: %s", createDescriptiveError(err))
 	}
@@ -261,7 +261,7 @@ func kafkaMirrorTopicUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	if d.HasChangesExcept(paramKafkaCluster, paramKafkaMirrorTopicCredentials, paramStatus) {
 		return diag.Errorf("error updating Kafka Mirror Topic %q: only %q and %q attributes can be updated for Kafka Mirror Topic", d.Id(), paramKafkaMirrorTopicCredentials, paramStatus)
 	}
-	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(d, meta)
+	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(ctx, d, meta)
 	if err != nil {
 		return diag.Errorf("error creating Kafka Mirror Topic: %s", createDescriptiveError(err))
 	}
@@ -344,9 +344,17 @@ func kafkaMirrorTopicUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	return kafkaMirrorTopicRead(ctx, d, meta)
 }
 
-func createKafkaRestClientFromKafkaBlock(d *schema.ResourceData, meta interface{}) (*KafkaRestClient, error) {
+func createKafkaRestClientFromKafkaBlock(ctx context.Context, d *schema.ResourceData, meta interface{}) (*KafkaRestClient, error) {
 	kafkaClusterId := extractStringValueFromBlock(d, paramKafkaCluster, paramId)
 	kafkaClusterRestEndpoint := extractStringValueFromBlock(d, paramKafkaCluster, paramRestEndpoint)
+	// The factory falls back to the rest_endpoint from the kafka_clusters blocks of the provider block if it's set there
+	if kafkaClusterRestEndpoint == "" && meta.(*Client).kafkaRestClientFactory.clusters[kafkaClusterId].restEndpoint == "" {
+		restEndpoint, err := lookupKafkaClusterRestEndpoint(ctx, meta.(*Client), kafkaClusterId, "")
+		if err != nil {
+			return nil, err
+		}
+		kafkaClusterRestEndpoint = restEndpoint
+	}
 	kafkaClusterApiKey := extractStringValueFromNestedBlock(d, paramKafkaCluster, paramCredentials, paramKey)
 	kafkaClusterApiSecret := extractStringValueFromNestedBlock(d, paramKafkaCluster, paramCredentials, paramSecret)
 	// Set isMetadataSetInProviderBlock to 'false' to disable inferring rest_endpoint / Kafka API Key from 'providers' block for confluent_cluster_link resource
@@ -441,6 +449,7 @@ func mirrorTopicKafkaClusterBlockSchema() *schema.Schema {
 				paramRestEndpoint: {
 					Type:         schema.TypeString,
 					Optional:     true,
+					Computed:     true,
 					ForceNew:     true,
 					Description:  "The REST endpoint of the Kafka cluster (e.g., `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
 					ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
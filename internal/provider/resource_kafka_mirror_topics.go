@@ -0,0 +1,433 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
+)
+
+func kafkaMirrorTopicsResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: kafkaMirrorTopicsCreate,
+		ReadContext:   kafkaMirrorTopicsRead,
+		UpdateContext: kafkaMirrorTopicsUpdate,
+		DeleteContext: kafkaMirrorTopicsDelete,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: mirrorTopicKafkaClusterBlockSchema(),
+			paramClusterLink:  clusterLinkBlockSchema(),
+			paramSourceTopicNames: {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Computed:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "The names of the source topics to be mirrored over the Cluster Link.",
+				ExactlyOneOf: []string{paramSourceTopicNames, paramSourceTopicNamePrefix},
+			},
+			paramSourceTopicNamePrefix: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The prefix of the names of the source topics to be mirrored over the Cluster Link.",
+				ValidateFunc: validation.StringIsNotEmpty,
+				RequiredWith: []string{paramSourceKafkaCluster},
+			},
+			paramSourceKafkaCluster: mirrorTopicsSourceKafkaClusterBlockSchema(),
+			paramMirrorTopicNamePrefix: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The prefix configured on the Cluster Link that is prepended to the names of the mirror topics.",
+			},
+			paramTopicsBetweenWaits: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      paramTopicsBetweenWaitsDefaultValue,
+				Description:  "The number of mirror topics to create or delete, one at a time, before waiting for them.",
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			paramMirrorTopics: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramSourceTopicName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramMirrorTopicName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramMirrorStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: kafkaMirrorTopicsCustomizeDiff,
+	}
+}
+
+func mirrorTopicsSourceKafkaClusterBlockSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		MaxItems:     1,
+		Description:  "The source Kafka cluster to match the source topics against. Required when the source topics are selected by a prefix.",
+		RequiredWith: []string{paramSourceTopicNamePrefix},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramId: {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The unique identifier for the source Kafka cluster.",
+				},
+				paramRestEndpoint: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  "The REST endpoint of the source Kafka cluster (e.g., `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+				},
+				paramCredentials: {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "The Kafka API Credentials of the source Kafka cluster.",
+					MinItems:    1,
+					MaxItems:    1,
+					Sensitive:   true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							paramKey: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Sensitive:    true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							paramSecret: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Sensitive:    true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// kafkaMirrorTopicsCustomizeDiff resolves the source topics that match the prefix at plan time,
+// so that source topics created after the last apply are mirrored, and deleted ones are no longer mirrored, on the next apply.
+func kafkaMirrorTopicsCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	sourceTopicNamePrefix := diff.Get(paramSourceTopicNamePrefix).(string)
+	if sourceTopicNamePrefix == "" && diff.NewValueKnown(paramSourceTopicNamePrefix) {
+		return nil
+	}
+	for _, key := range []string{paramSourceTopicNamePrefix, fmt.Sprintf("%s.0.%s", paramSourceKafkaCluster, paramId), fmt.Sprintf("%s.0.%s", paramSourceKafkaCluster, paramRestEndpoint), fmt.Sprintf("%s.0.%s", paramSourceKafkaCluster, paramCredentials)} {
+		if !diff.NewValueKnown(key) {
+			return diff.SetNewComputed(paramSourceTopicNames)
+		}
+	}
+
+	sourceKafkaRestClient := createKafkaRestClientFromSourceKafkaClusterBlock(diff.Get(paramSourceKafkaCluster).([]interface{}), meta)
+	sourceTopicNames, err := listKafkaTopicNamesWithPrefix(ctx, sourceKafkaRestClient, sourceTopicNamePrefix)
+	if err != nil {
+		return fmt.Errorf("error listing source topics with %q prefix: %s", sourceTopicNamePrefix, createDescriptiveError(err))
+	}
+	if len(sourceTopicNames) == 0 {
+		return fmt.Errorf("no source topics with %q prefix found on Kafka cluster %q", sourceTopicNamePrefix, sourceKafkaRestClient.clusterId)
+	}
+	newSourceTopicNames := schema.NewSet(schema.HashString, lo.Map(sourceTopicNames, func(sourceTopicName string, _ int) interface{} { return sourceTopicName }))
+	if newSourceTopicNames.Equal(diff.Get(paramSourceTopicNames)) {
+		return nil
+	}
+	return diff.SetNew(paramSourceTopicNames, newSourceTopicNames)
+}
+
+func kafkaMirrorTopicsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(ctx, d, meta)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Mirror Topics: %s", createDescriptiveError(err))
+	}
+	linkName := extractStringValueFromBlock(d, paramClusterLink, paramLinkName)
+	kafkaMirrorTopicsId := createKafkaMirrorTopicsId(kafkaRestClient.clusterId, linkName)
+
+	sourceTopicNames := extractSortedSourceTopicNames(d.Get(paramSourceTopicNames).(*schema.Set))
+	if len(sourceTopicNames) == 0 {
+		// The source topics that match the prefix couldn't be resolved at plan time
+		sourceTopicNames, err = listKafkaTopicNamesWithPrefix(ctx, createKafkaRestClientFromSourceKafkaClusterBlock(d.Get(paramSourceKafkaCluster).([]interface{}), meta), d.Get(paramSourceTopicNamePrefix).(string))
+		if err != nil {
+			return diag.Errorf("error creating Kafka Mirror Topics %q: error listing source topics: %s", kafkaMirrorTopicsId, createDescriptiveError(err))
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Creating %d new Kafka Mirror Topics %q", len(sourceTopicNames), kafkaMirrorTopicsId), map[string]interface{}{kafkaMirrorTopicLoggingKey: kafkaMirrorTopicsId})
+	createdSourceTopicNames, err := createKafkaMirrorTopics(ctx, kafkaRestClient, linkName, sourceTopicNames, d.Get(paramMirrorTopicNamePrefix).(string), d.Get(paramTopicsBetweenWaits).(int), meta)
+	if err != nil && len(createdSourceTopicNames) == 0 {
+		return diag.Errorf("error creating Kafka Mirror Topics %q: %s", kafkaMirrorTopicsId, createDescriptiveError(err))
+	}
+	d.SetId(kafkaMirrorTopicsId)
+	if err != nil {
+		// Keep track of the mirror topics that have been created so that the next plan creates the remaining ones
+		// instead of tainting the resource
+		tflog.Warn(ctx, fmt.Sprintf("Created only %d of %d Kafka Mirror Topics %q: %s", len(createdSourceTopicNames), len(sourceTopicNames), d.Id(), createDescriptiveError(err)), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+		if err := d.Set(paramSourceTopicNames, createdSourceTopicNames); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+		diags := kafkaMirrorTopicsRead(ctx, d, meta)
+		return append(diags, kafkaMirrorTopicsPartiallyCreatedWarning(len(createdSourceTopicNames), len(sourceTopicNames), err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Kafka Mirror Topics %q", d.Id()), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+
+	return kafkaMirrorTopicsRead(ctx, d, meta)
+}
+
+func kafkaMirrorTopicsPartiallyCreatedWarning(createdCount, totalCount int, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Kafka Mirror Topics were created partially",
+		Detail:   fmt.Sprintf("Only %d out of %d mirror topics were created: %s\n\nThe created mirror topics are saved to the Terraform state. Re-run 'terraform apply' to create the remaining mirror topics.", createdCount, totalCount, createDescriptiveError(err)),
+	}
+}
+
+func kafkaMirrorTopicsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Mirror Topics %q", d.Id()), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+
+	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(ctx, d, meta)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Mirror Topics %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	linkName := extractStringValueFromBlock(d, paramClusterLink, paramLinkName)
+
+	mirrorTopics, resp, err := kafkaRestClient.apiClient.ClusterLinkingV3Api.ListKafkaMirrorTopicsUnderLink(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId, linkName).Execute()
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error reading Kafka Mirror Topics %q: %s", d.Id(), createDescriptiveError(err, resp)), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+
+		isResourceNotFound := ResponseHasExpectedStatusCode(resp, http.StatusNotFound)
+		if isResourceNotFound && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Kafka Mirror Topics %q in TF state because Cluster Link %q could not be found on the server", d.Id(), linkName), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+			d.SetId("")
+			return nil
+		}
+
+		return diag.Errorf("error reading Kafka Mirror Topics %q: %s", d.Id(), createDescriptiveError(err, resp))
+	}
+	mirrorTopicsJson, err := json.Marshal(mirrorTopics)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Mirror Topics %q: error marshaling %#v to json: %s", d.Id(), mirrorTopics, createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Fetched Kafka Mirror Topics %q: %s", d.Id(), mirrorTopicsJson), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+
+	mirrorTopicStatuses := extractMirrorTopicStatuses(mirrorTopics.Data)
+	mirrorTopicNamePrefix := d.Get(paramMirrorTopicNamePrefix).(string)
+	existingSourceTopicNames := make([]string, 0)
+	mirrorTopicsResult := make([]interface{}, 0)
+	for _, sourceTopicName := range extractSortedSourceTopicNames(d.Get(paramSourceTopicNames).(*schema.Set)) {
+		mirrorTopicName := mirrorTopicNamePrefix + sourceTopicName
+		mirrorStatus, ok := mirrorTopicStatuses[mirrorTopicName]
+		if !ok {
+			// The mirror topic is recreated on the next apply
+			tflog.Warn(ctx, fmt.Sprintf("Kafka Mirror Topic %q of Kafka Mirror Topics %q could not be found on the server", mirrorTopicName, d.Id()), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+			continue
+		}
+		existingSourceTopicNames = append(existingSourceTopicNames, sourceTopicName)
+		mirrorTopicsResult = append(mirrorTopicsResult, map[string]interface{}{
+			paramSourceTopicName: sourceTopicName,
+			paramMirrorTopicName: mirrorTopicName,
+			paramMirrorStatus:    mirrorStatus,
+		})
+	}
+	if err := d.Set(paramSourceTopicNames, existingSourceTopicNames); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	if err := d.Set(paramMirrorTopics, mirrorTopicsResult); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka Mirror Topics %q", d.Id()), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+
+	return nil
+}
+
+func kafkaMirrorTopicsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramKafkaCluster, paramKafkaMirrorTopicCredentials, paramSourceTopicNames, paramSourceTopicNamePrefix, paramSourceKafkaCluster, paramTopicsBetweenWaits) {
+		return diag.Errorf("error updating Kafka Mirror Topics %q: only %q, %q, %q, %q and %q attributes can be updated for Kafka Mirror Topics", d.Id(), paramKafkaMirrorTopicCredentials, paramSourceTopicNames, paramSourceTopicNamePrefix, paramSourceKafkaCluster, paramTopicsBetweenWaits)
+	}
+	if d.HasChange(paramSourceTopicNames) {
+		kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(ctx, d, meta)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Mirror Topics %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		linkName := extractStringValueFromBlock(d, paramClusterLink, paramLinkName)
+		mirrorTopicNamePrefix := d.Get(paramMirrorTopicNamePrefix).(string)
+		topicsBetweenWaits := d.Get(paramTopicsBetweenWaits).(int)
+
+		oldSourceTopicNamesSet, newSourceTopicNamesSet := d.GetChange(paramSourceTopicNames)
+		oldSourceTopicNames := extractSortedSourceTopicNames(oldSourceTopicNamesSet.(*schema.Set))
+		sourceTopicNamesToDelete := extractSortedSourceTopicNames(oldSourceTopicNamesSet.(*schema.Set).Difference(newSourceTopicNamesSet.(*schema.Set)))
+		sourceTopicNamesToCreate := extractSortedSourceTopicNames(newSourceTopicNamesSet.(*schema.Set).Difference(oldSourceTopicNamesSet.(*schema.Set)))
+
+		tflog.Debug(ctx, fmt.Sprintf("Updating Kafka Mirror Topics %q: deleting %d and creating %d Kafka Mirror Topics", d.Id(), len(sourceTopicNamesToDelete), len(sourceTopicNamesToCreate)), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+		deletedSourceTopicNames, err := deleteKafkaMirrorTopics(ctx, kafkaRestClient, linkName, sourceTopicNamesToDelete, mirrorTopicNamePrefix, topicsBetweenWaits, meta.(*Client).isAcceptanceTestMode)
+		if err == nil {
+			var createdSourceTopicNames []string
+			createdSourceTopicNames, err = createKafkaMirrorTopics(ctx, kafkaRestClient, linkName, sourceTopicNamesToCreate, mirrorTopicNamePrefix, topicsBetweenWaits, meta)
+			oldSourceTopicNames = append(oldSourceTopicNames, createdSourceTopicNames...)
+		}
+		if err != nil {
+			// Keep track of the mirror topics that have been created or deleted
+			if err := d.Set(paramSourceTopicNames, excludeSourceTopicNames(oldSourceTopicNames, deletedSourceTopicNames)); err != nil {
+				return diag.FromErr(createDescriptiveError(err))
+			}
+			return diag.Errorf("error updating Kafka Mirror Topics %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Finished updating Kafka Mirror Topics %q", d.Id()), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+	}
+	return kafkaMirrorTopicsRead(ctx, d, meta)
+}
+
+func kafkaMirrorTopicsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Kafka Mirror Topics %q", d.Id()), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+
+	kafkaRestClient, err := createKafkaRestClientFromKafkaBlock(ctx, d, meta)
+	if err != nil {
+		return diag.Errorf("error deleting Kafka Mirror Topics %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	linkName := extractStringValueFromBlock(d, paramClusterLink, paramLinkName)
+	sourceTopicNames := extractSortedSourceTopicNames(d.Get(paramSourceTopicNames).(*schema.Set))
+
+	deletedSourceTopicNames, err := deleteKafkaMirrorTopics(ctx, kafkaRestClient, linkName, sourceTopicNames, d.Get(paramMirrorTopicNamePrefix).(string), d.Get(paramTopicsBetweenWaits).(int), meta.(*Client).isAcceptanceTestMode)
+	if err != nil {
+		// Keep track of the mirror topics that haven't been deleted
+		if err := d.Set(paramSourceTopicNames, excludeSourceTopicNames(sourceTopicNames, deletedSourceTopicNames)); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+		return diag.Errorf("error deleting Kafka Mirror Topics %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Kafka Mirror Topics %q", d.Id()), map[string]interface{}{kafkaMirrorTopicLoggingKey: d.Id()})
+
+	return nil
+}
+
+// createKafkaMirrorTopics creates the mirror topics of the given source topics one at a time, waiting after every
+// topicsBetweenWaits mirror topics, and returns the source topics whose mirror topics have been created.
+func createKafkaMirrorTopics(ctx context.Context, c *KafkaRestClient, linkName string, sourceTopicNames []string, mirrorTopicNamePrefix string, topicsBetweenWaits int, meta interface{}) ([]string, error) {
+	createdSourceTopicNames := make([]string, 0, len(sourceTopicNames))
+	for _, chunk := range lo.Chunk(sourceTopicNames, topicsBetweenWaits) {
+		for _, sourceTopicName := range chunk {
+			mirrorTopicName := ""
+			if mirrorTopicNamePrefix != "" {
+				mirrorTopicName = mirrorTopicNamePrefix + sourceTopicName
+			}
+			createKafkaMirrorTopicRequest, err := constructKafkaMirrorTopicRequest(sourceTopicName, mirrorTopicName)
+			if err != nil {
+				return createdSourceTopicNames, err
+			}
+			resp, err := executeKafkaMirrorTopicCreate(ctx, c, createKafkaMirrorTopicRequest, linkName)
+			if err != nil {
+				return createdSourceTopicNames, fmt.Errorf("error creating Kafka Mirror Topic of source topic %q: %s", sourceTopicName, createDescriptiveError(err, resp))
+			}
+			createdSourceTopicNames = append(createdSourceTopicNames, sourceTopicName)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Created %d of %d Kafka Mirror Topics of Cluster Link %q", len(createdSourceTopicNames), len(sourceTopicNames), linkName))
+
+		// https://github.com/confluentinc/terraform-provider-confluentcloud/issues/40#issuecomment-1048782379
+		SleepIfNotTestMode(kafkaRestAPIWaitAfterCreate, meta.(*Client).isAcceptanceTestMode, meta.(*Client).isLiveProductionTestMode)
+	}
+	return createdSourceTopicNames, nil
+}
+
+// deleteKafkaMirrorTopics deletes the mirror topics of the given source topics one at a time, waiting for every
+// topicsBetweenWaits mirror topics to be deleted, and returns the source topics whose mirror topics have been deleted.
+func deleteKafkaMirrorTopics(ctx context.Context, c *KafkaRestClient, linkName string, sourceTopicNames []string, mirrorTopicNamePrefix string, topicsBetweenWaits int, isAcceptanceTestMode bool) ([]string, error) {
+	deletedSourceTopicNames := make([]string, 0, len(sourceTopicNames))
+	for _, chunk := range lo.Chunk(sourceTopicNames, topicsBetweenWaits) {
+		for _, sourceTopicName := range chunk {
+			resp, err := c.apiClient.TopicV3Api.DeleteKafkaTopic(c.apiContext(ctx), c.clusterId, mirrorTopicNamePrefix+sourceTopicName).Execute()
+			if err != nil && !ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+				return deletedSourceTopicNames, fmt.Errorf("error deleting Kafka Mirror Topic %q: %s", mirrorTopicNamePrefix+sourceTopicName, createDescriptiveError(err, resp))
+			}
+		}
+		for _, sourceTopicName := range chunk {
+			if err := waitForKafkaMirrorTopicToBeDeleted(c.apiContext(ctx), c, linkName, mirrorTopicNamePrefix+sourceTopicName, isAcceptanceTestMode); err != nil {
+				return deletedSourceTopicNames, fmt.Errorf("error waiting for Kafka Mirror Topic %q to be deleted: %s", mirrorTopicNamePrefix+sourceTopicName, createDescriptiveError(err))
+			}
+			deletedSourceTopicNames = append(deletedSourceTopicNames, sourceTopicName)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Deleted %d of %d Kafka Mirror Topics of Cluster Link %q", len(deletedSourceTopicNames), len(sourceTopicNames), linkName))
+	}
+	return deletedSourceTopicNames, nil
+}
+
+func listKafkaTopicNamesWithPrefix(ctx context.Context, c *KafkaRestClient, topicNamePrefix string) ([]string, error) {
+	topics, resp, err := c.apiClient.TopicV3Api.ListKafkaTopics(c.apiContext(ctx), c.clusterId).Execute()
+	if err != nil {
+		return nil, createDescriptiveError(err, resp)
+	}
+	topicNames := make([]string, 0)
+	for _, topic := range topics.GetData() {
+		if topic.GetIsInternal() || shouldFilterOutTopic(topic.GetTopicName()) || !strings.HasPrefix(topic.GetTopicName(), topicNamePrefix) {
+			continue
+		}
+		topicNames = append(topicNames, topic.GetTopicName())
+	}
+	sort.Strings(topicNames)
+	return topicNames, nil
+}
+
+func createKafkaRestClientFromSourceKafkaClusterBlock(sourceKafkaCluster []interface{}, meta interface{}) *KafkaRestClient {
+	var clusterId, restEndpoint, clusterApiKey, clusterApiSecret string
+	if len(sourceKafkaCluster) > 0 && sourceKafkaCluster[0] != nil {
+		sourceKafkaClusterBlock := sourceKafkaCluster[0].(map[string]interface{})
+		clusterId = sourceKafkaClusterBlock[paramId].(string)
+		restEndpoint = sourceKafkaClusterBlock[paramRestEndpoint].(string)
+		if credentials := sourceKafkaClusterBlock[paramCredentials].([]interface{}); len(credentials) > 0 && credentials[0] != nil {
+			clusterApiKey = credentials[0].(map[string]interface{})[paramKey].(string)
+			clusterApiSecret = credentials[0].(map[string]interface{})[paramSecret].(string)
+		}
+	}
+	return meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, false, false, meta.(*Client).oauthToken)
+}
+
+func extractSortedSourceTopicNames(sourceTopicNames *schema.Set) []string {
+	sortedSourceTopicNames := convertToStringSlice(sourceTopicNames.List())
+	sort.Strings(sortedSourceTopicNames)
+	return sortedSourceTopicNames
+}
+
+func excludeSourceTopicNames(sourceTopicNames, excludedSourceTopicNames []string) []string {
+	return lo.Filter(sourceTopicNames, func(sourceTopicName string, _ int) bool {
+		return !lo.Contains(excludedSourceTopicNames, sourceTopicName)
+	})
+}
+
+// Kafka Mirror Topics ID = lkc-kjnkvg/test_link
+func createKafkaMirrorTopicsId(clusterId, linkName string) string {
+	return fmt.Sprintf("%s/%s", clusterId, linkName)
+}
@@ -0,0 +1,320 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+var fullKafkaMirrorTopicsResourceLabel = fmt.Sprintf("confluent_kafka_mirror_topics.%s", kafkaMirrorTopicsResourceLabel)
+
+var kafkaMirrorTopicsPath = fmt.Sprintf("/kafka/v3/clusters/%s/links/%s/mirrors", destinationClusterId, clusterLinkName)
+var deleteOrdersKafkaMirrorTopicPath = fmt.Sprintf("/kafka/v3/clusters/%s/topics/orders", destinationClusterId)
+var deletePaymentsKafkaMirrorTopicPath = fmt.Sprintf("/kafka/v3/clusters/%s/topics/payments", destinationClusterId)
+
+func TestAccKafkaMirrorTopics(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createMirrorTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_mirror_topic/regular/create_kafka_mirror_topic.json")
+	createOrdersMirrorTopicStub := wiremock.Post(wiremock.URLPathEqualTo(kafkaMirrorTopicsPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.EqualToJson(`{"source_topic_name":"orders"}`)).
+		WillReturn(
+			string(createMirrorTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createOrdersMirrorTopicStub)
+
+	// Mirror topics are created in the order of their source topic names
+	createPaymentsMirrorTopicStub := wiremock.Post(wiremock.URLPathEqualTo(kafkaMirrorTopicsPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.EqualToJson(`{"source_topic_name":"payments"}`)).
+		WillSetStateTo(scenarioStateKafkaMirrorTopicsHaveBeenCreated).
+		WillReturn(
+			string(createMirrorTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createPaymentsMirrorTopicStub)
+
+	listMirrorTopicsResponse, _ := ioutil.ReadFile("../testdata/kafka_mirror_topics/list_kafka_mirror_topics.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaMirrorTopicsPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenCreated).
+		WillReturn(
+			string(listMirrorTopicsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteOrdersMirrorTopicStub := wiremock.Delete(wiremock.URLPathEqualTo(deleteOrdersKafkaMirrorTopicPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenCreated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteOrdersMirrorTopicStub)
+
+	deletePaymentsMirrorTopicStub := wiremock.Delete(wiremock.URLPathEqualTo(deletePaymentsKafkaMirrorTopicPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenCreated).
+		WillSetStateTo(scenarioStateKafkaMirrorTopicsHaveBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deletePaymentsMirrorTopicStub)
+
+	for _, mirrorTopicName := range []string{"orders", "payments"} {
+		_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(fmt.Sprintf("%s/%s", kafkaMirrorTopicsPath, mirrorTopicName))).
+			InScenario(kafkaMirrorTopicsScenarioName).
+			WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenDeleted).
+			WillReturn(
+				"",
+				contentTypeJSONHeader,
+				http.StatusNotFound,
+			))
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckKafkaMirrorTopicsConfig(confluentCloudBaseUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "id", fmt.Sprintf("%s/%s", destinationClusterId, clusterLinkName)),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "cluster_link.#", "1"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "cluster_link.0.link_name", clusterLinkName),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "kafka_cluster.0.id", destinationClusterId),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "topics_between_waits", "20"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "source_topic_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(fullKafkaMirrorTopicsResourceLabel, "source_topic_names.*", "orders"),
+					resource.TestCheckTypeSetElemAttr(fullKafkaMirrorTopicsResourceLabel, "source_topic_names.*", "payments"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "mirror_topics.#", "2"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "mirror_topics.0.source_topic_name", "orders"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "mirror_topics.0.mirror_topic_name", "orders"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "mirror_topics.0.mirror_status", stateActive),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "mirror_topics.1.source_topic_name", "payments"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "mirror_topics.1.mirror_topic_name", "payments"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "mirror_topics.1.mirror_status", stateActive),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createOrdersMirrorTopicStub, fmt.Sprintf("POST %s", kafkaMirrorTopicsPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, createPaymentsMirrorTopicStub, fmt.Sprintf("POST %s", kafkaMirrorTopicsPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteOrdersMirrorTopicStub, fmt.Sprintf("DELETE %s", deleteOrdersKafkaMirrorTopicPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deletePaymentsMirrorTopicStub, fmt.Sprintf("DELETE %s", deletePaymentsKafkaMirrorTopicPath), expectedCountOne)
+}
+
+func TestAccKafkaMirrorTopicsPartiallyCreated(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createMirrorTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_mirror_topic/regular/create_kafka_mirror_topic.json")
+	createOrdersMirrorTopicStub := wiremock.Post(wiremock.URLPathEqualTo(kafkaMirrorTopicsPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WithBodyPattern(wiremock.EqualToJson(`{"source_topic_name":"orders"}`)).
+		WillSetStateTo(scenarioStateKafkaOrdersMirrorTopicHasBeenCreated).
+		WillReturn(
+			string(createMirrorTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createOrdersMirrorTopicStub)
+
+	// The first attempt to create the mirror topic of "payments" fails
+	failPaymentsMirrorTopicStub := wiremock.Post(wiremock.URLPathEqualTo(kafkaMirrorTopicsPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaOrdersMirrorTopicHasBeenCreated).
+		WithBodyPattern(wiremock.EqualToJson(`{"source_topic_name":"payments"}`)).
+		WillSetStateTo(scenarioStateKafkaPaymentsMirrorTopicHasFailed).
+		WillReturn(
+			`{"error_code": 40002, "message": "Topic 'payments' already exists."}`,
+			contentTypeJSONHeader,
+			http.StatusBadRequest,
+		)
+	_ = wiremockClient.StubFor(failPaymentsMirrorTopicStub)
+
+	createPaymentsMirrorTopicStub := wiremock.Post(wiremock.URLPathEqualTo(kafkaMirrorTopicsPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaPaymentsMirrorTopicHasFailed).
+		WithBodyPattern(wiremock.EqualToJson(`{"source_topic_name":"payments"}`)).
+		WillSetStateTo(scenarioStateKafkaMirrorTopicsHaveBeenCreated).
+		WillReturn(
+			string(createMirrorTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		)
+	_ = wiremockClient.StubFor(createPaymentsMirrorTopicStub)
+
+	listOrdersMirrorTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_mirror_topics/list_kafka_orders_mirror_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaMirrorTopicsPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaPaymentsMirrorTopicHasFailed).
+		WillReturn(
+			string(listOrdersMirrorTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	listMirrorTopicsResponse, _ := ioutil.ReadFile("../testdata/kafka_mirror_topics/list_kafka_mirror_topics.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaMirrorTopicsPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenCreated).
+		WillReturn(
+			string(listMirrorTopicsResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteOrdersMirrorTopicStub := wiremock.Delete(wiremock.URLPathEqualTo(deleteOrdersKafkaMirrorTopicPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenCreated).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteOrdersMirrorTopicStub)
+
+	deletePaymentsMirrorTopicStub := wiremock.Delete(wiremock.URLPathEqualTo(deletePaymentsKafkaMirrorTopicPath)).
+		InScenario(kafkaMirrorTopicsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenCreated).
+		WillSetStateTo(scenarioStateKafkaMirrorTopicsHaveBeenDeleted).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deletePaymentsMirrorTopicStub)
+
+	for _, mirrorTopicName := range []string{"orders", "payments"} {
+		_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(fmt.Sprintf("%s/%s", kafkaMirrorTopicsPath, mirrorTopicName))).
+			InScenario(kafkaMirrorTopicsScenarioName).
+			WhenScenarioStateIs(scenarioStateKafkaMirrorTopicsHaveBeenDeleted).
+			WillReturn(
+				"",
+				contentTypeJSONHeader,
+				http.StatusNotFound,
+			))
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Only the mirror topic of "orders" is created, and the resource isn't tainted
+				Config: testAccCheckKafkaMirrorTopicsConfig(confluentCloudBaseUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "id", fmt.Sprintf("%s/%s", destinationClusterId, clusterLinkName)),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "source_topic_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(fullKafkaMirrorTopicsResourceLabel, "source_topic_names.*", "orders"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "mirror_topics.#", "1"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "mirror_topics.0.source_topic_name", "orders"),
+				),
+				// The remaining mirror topic is planned to be created
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Only the remaining mirror topic is created
+				Config: testAccCheckKafkaMirrorTopicsConfig(confluentCloudBaseUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "id", fmt.Sprintf("%s/%s", destinationClusterId, clusterLinkName)),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "source_topic_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(fullKafkaMirrorTopicsResourceLabel, "source_topic_names.*", "orders"),
+					resource.TestCheckTypeSetElemAttr(fullKafkaMirrorTopicsResourceLabel, "source_topic_names.*", "payments"),
+					resource.TestCheckResourceAttr(fullKafkaMirrorTopicsResourceLabel, "mirror_topics.#", "2"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createOrdersMirrorTopicStub, fmt.Sprintf("POST %s", kafkaMirrorTopicsPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, failPaymentsMirrorTopicStub, fmt.Sprintf("POST %s", kafkaMirrorTopicsPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, createPaymentsMirrorTopicStub, fmt.Sprintf("POST %s", kafkaMirrorTopicsPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteOrdersMirrorTopicStub, fmt.Sprintf("DELETE %s", deleteOrdersKafkaMirrorTopicPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deletePaymentsMirrorTopicStub, fmt.Sprintf("DELETE %s", deletePaymentsKafkaMirrorTopicPath), expectedCountOne)
+}
+
+func testAccCheckKafkaMirrorTopicsConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+	  endpoint = "%s"
+	}
+	resource "confluent_kafka_mirror_topics" "%s" {
+	  source_topic_names = ["payments", "orders"]
+	  cluster_link {
+	    link_name = "%s"
+	  }
+	  kafka_cluster {
+	    id = "%s"
+	    rest_endpoint = "%s"
+	    credentials {
+	      key = "%s"
+	      secret = "%s"
+	    }
+	  }
+	}
+	`, confluentCloudBaseUrl, kafkaMirrorTopicsResourceLabel, clusterLinkName,
+		destinationClusterId, mockServerUrl, destinationClusterApiKey, destinationClusterApiSecret)
+}
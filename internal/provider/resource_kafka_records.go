@@ -0,0 +1,512 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+)
+
+const (
+	recordDataTypeString = "STRING"
+	recordDataTypeJson   = "JSON"
+	recordDataTypeBinary = "BINARY"
+)
+
+var acceptedRecordDataTypes = []string{recordDataTypeString, recordDataTypeJson, recordDataTypeBinary}
+var acceptedSubjectNameStrategies = []string{"TOPIC_NAME", "RECORD_NAME", "TOPIC_RECORD_NAME"}
+
+// produceRecordRequest mirrors kafkarestv3.ProduceRequest, but kafkarestv3.ProduceRequestData doesn't support
+// Schema Registry serialization fields (schema_id, subject, subject_name_strategy, schema_version) yet.
+// https://docs.confluent.io/cloud/current/api.html#tag/Records-(v3)/operation/produceRecord
+type produceRecordRequest struct {
+	PartitionId *int32                             `json:"partition_id,omitempty"`
+	Headers     []kafkarestv3.ProduceRequestHeader `json:"headers,omitempty"`
+	Key         *produceRecordRequestData          `json:"key,omitempty"`
+	Value       *produceRecordRequestData          `json:"value,omitempty"`
+}
+
+type produceRecordRequestData struct {
+	Type                string      `json:"type,omitempty"`
+	Data                interface{} `json:"data"`
+	SchemaId            int32       `json:"schema_id,omitempty"`
+	Subject             string      `json:"subject,omitempty"`
+	SubjectNameStrategy string      `json:"subject_name_strategy,omitempty"`
+	SchemaVersion       int32       `json:"schema_version,omitempty"`
+}
+
+func kafkaRecordsResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: kafkaRecordsCreate,
+		ReadContext:   kafkaRecordsRead,
+		UpdateContext: kafkaRecordsUpdate,
+		DeleteContext: kafkaRecordsDelete,
+		Schema: map[string]*schema.Schema{
+			paramKafkaCluster: optionalKafkaClusterBlockSchema(),
+			paramTopicName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The name of the topic to produce records to, for example, `orders-1`.",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9\\._\-]+$`), "The topic name can be up to 249 characters in length, and can include the following characters: a-z, A-Z, 0-9, . (dot), _ (underscore), and - (dash)."),
+			},
+			paramRestEndpoint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The REST endpoint of the Kafka cluster (e.g., `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+			},
+			paramCredentials: credentialsSchema(),
+			paramRecord: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "The records to produce, in order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramKey:   recordDataSchema("The key of the record."),
+						paramValue: recordDataSchema("The value of the record."),
+						paramHeader: {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									paramName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									paramValue: {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						paramPartition: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ForceNew:     true,
+							Description:  "The partition to produce the record to. If omitted, the partition is chosen by the cluster based on the record key.",
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			paramProducedRecords: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The partitions and offsets the records were produced to, in the same order as `record` blocks.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramPartition: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						paramOffset: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: customdiff.Sequence(resourceCredentialBlockValidationWithOAuth, kafkaRecordsCustomizeDiff),
+	}
+}
+
+func recordDataSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		ForceNew:    true,
+		MaxItems:    1,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramType: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The format of `data`. Defaults to `STRING` unless Schema Registry serialization is used.",
+					ValidateFunc: validation.StringInSlice(acceptedRecordDataTypes, false),
+				},
+				paramData: {
+					Type:        schema.TypeString,
+					Required:    true,
+					ForceNew:    true,
+					Description: "The data of the record key or value.",
+				},
+				paramSchemaId: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The ID of the Schema Registry schema to serialize `data` with.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+				paramSubject: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The Schema Registry subject to look up the schema to serialize `data` with.",
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+				paramSubjectNameStrategy: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The subject name strategy to look up the schema to serialize `data` with.",
+					ValidateFunc: validation.StringInSlice(acceptedSubjectNameStrategies, false),
+				},
+				paramSchemaVersion: {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					Description:  "The version of the subject schema to serialize `data` with. Defaults to the latest version.",
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func kafkaRecordsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Records: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Records: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Records: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaClusterIdSet, meta.(*Client).isKafkaMetadataSet, meta.(*Client).oauthToken)
+	topicName := d.Get(paramTopicName).(string)
+
+	produceRecordRequests, err := constructProduceRecordRequestsWithPartitions(d)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Records: %s", createDescriptiveError(err))
+	}
+
+	producedRecords, produceErr := produceKafkaRecords(ctx, kafkaRestClient, topicName, produceRecordRequests, make([]interface{}, 0, len(produceRecordRequests)))
+	if len(producedRecords) == 0 {
+		return diag.Errorf("error creating Kafka Records: %s", produceErr)
+	}
+
+	// The records that have been produced successfully are saved to TF state even if producing a later record
+	// failed, since records can't be removed from a topic. The remaining records are produced by the next
+	// 'terraform apply' (see kafkaRecordsCustomizeDiff and kafkaRecordsUpdate), so they're never produced twice.
+	firstProducedRecord := producedRecords[0].(map[string]interface{})
+	d.SetId(createKafkaRecordsId(kafkaRestClient.clusterId, topicName, firstProducedRecord[paramPartition].(int), firstProducedRecord[paramOffset].(int)))
+	if err := d.Set(paramProducedRecords, producedRecords); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Kafka Records %q", d.Id()), map[string]interface{}{kafkaRecordsLoggingKey: d.Id()})
+
+	diags := kafkaRecordsRead(ctx, d, meta)
+	if produceErr != nil && !diags.HasError() {
+		// Returning an error from Create would mark the resource as tainted, and replacing it would produce
+		// the records that have been produced already again.
+		diags = append(diags, kafkaRecordsPartiallyProducedWarning(len(producedRecords), len(produceRecordRequests), produceErr))
+	}
+	return diags
+}
+
+// produceKafkaRecords produces the records starting after the ones in producedRecords, in order, and returns
+// all produced records. It stops at the first record that fails to be produced and returns the records
+// produced so far together with the error.
+func produceKafkaRecords(ctx context.Context, c *KafkaRestClient, topicName string, produceRecordRequests []produceRecordRequest, producedRecords []interface{}) ([]interface{}, error) {
+	for i := len(producedRecords); i < len(produceRecordRequests); i++ {
+		produceRecordRequest := produceRecordRequests[i]
+		produceRecordRequestJson, err := json.Marshal(produceRecordRequest)
+		if err != nil {
+			return producedRecords, fmt.Errorf("error marshaling %#v to json: %s", produceRecordRequest, createDescriptiveError(err))
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Producing record #%d to Kafka Topic %q: %s", i, topicName, produceRecordRequestJson))
+
+		produceResponse, resp, err := executeKafkaRecordProduce(ctx, c, topicName, produceRecordRequest)
+		if err != nil {
+			return producedRecords, fmt.Errorf("error producing record #%d: %s", i, createDescriptiveError(err, resp))
+		}
+		if produceResponse.ErrorCode != 0 && produceResponse.ErrorCode != http.StatusOK {
+			return producedRecords, fmt.Errorf("error producing record #%d: %d %s", i, produceResponse.ErrorCode, produceResponse.GetMessage())
+		}
+		producedRecords = append(producedRecords, map[string]interface{}{
+			paramPartition: int(produceResponse.GetPartitionId()),
+			paramOffset:    int(produceResponse.GetOffset()),
+		})
+	}
+	return producedRecords, nil
+}
+
+func kafkaRecordsPartiallyProducedWarning(producedCount, totalCount int, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Kafka Records were produced partially",
+		Detail:   fmt.Sprintf("Only %d out of %d records were produced: %s\n\nThe produced records are saved to the Terraform state. Re-run 'terraform apply' to produce the remaining records.", producedCount, totalCount, err),
+	}
+}
+
+// kafkaRecordsCustomizeDiff plans an update that produces the remaining records when
+// a previous 'terraform apply' managed to produce only some of them.
+func kafkaRecordsCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || diff.HasChange(paramRecord) || diff.HasChange(paramTopicName) {
+		return nil
+	}
+	if len(diff.Get(paramProducedRecords).([]interface{})) < len(diff.Get(paramRecord).([]interface{})) {
+		return diff.SetNewComputed(paramProducedRecords)
+	}
+	return nil
+}
+
+func executeKafkaRecordProduce(ctx context.Context, c *KafkaRestClient, topicName string, requestData produceRecordRequest) (kafkarestv3.ProduceResponse, *http.Response, error) {
+	var produceResponse kafkarestv3.ProduceResponse
+	path := fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s/records", url.PathEscape(c.clusterId), url.PathEscape(topicName))
+	resp, err := c.executeRawRequest(ctx, http.MethodPost, path, requestData, &produceResponse)
+	return produceResponse, resp, err
+}
+
+func kafkaRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Records %q", d.Id()), map[string]interface{}{kafkaRecordsLoggingKey: d.Id()})
+
+	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Records: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Records: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Kafka Records: %s", createDescriptiveError(err))
+	}
+	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaClusterIdSet, meta.(*Client).isKafkaMetadataSet, meta.(*Client).oauthToken)
+	topicName := d.Get(paramTopicName).(string)
+
+	// Produced records can't be read back via Kafka REST API, so make sure the topic they were produced to still exists.
+	// Otherwise, the records are gone too and should be produced again.
+	_, resp, err := kafkaRestClient.apiClient.TopicV3Api.GetKafkaTopic(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId, topicName).Execute()
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Error reading Kafka Topic %q of Kafka Records %q: %s", topicName, d.Id(), createDescriptiveError(err, resp)), map[string]interface{}{kafkaRecordsLoggingKey: d.Id()})
+
+		isResourceNotFound := ResponseHasExpectedStatusCode(resp, http.StatusNotFound)
+		if isResourceNotFound && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("Removing Kafka Records %q in TF state because Kafka Topic %q could not be found on the server", d.Id(), topicName), map[string]interface{}{kafkaRecordsLoggingKey: d.Id()})
+			d.SetId("")
+			return nil
+		}
+
+		return diag.FromErr(createDescriptiveError(err, resp))
+	}
+
+	if !kafkaRestClient.isClusterIdSetInProviderBlock {
+		if err := setStringAttributeInListBlockOfSizeOne(paramKafkaCluster, paramId, kafkaRestClient.clusterId, d); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+	}
+	if !kafkaRestClient.isMetadataSetInProviderBlock {
+		if err := setKafkaCredentials(kafkaRestClient.clusterApiKey, kafkaRestClient.clusterApiSecret, d, kafkaRestClient.externalAccessToken != nil); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+		if err := d.Set(paramRestEndpoint, kafkaRestClient.restEndpoint); err != nil {
+			return diag.FromErr(createDescriptiveError(err))
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka Records %q", d.Id()), map[string]interface{}{kafkaRecordsLoggingKey: d.Id()})
+
+	return nil
+}
+
+func kafkaRecordsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramCredentials, paramRestEndpoint, paramProducedRecords) {
+		return diag.Errorf("error updating Kafka Records %q: only %q and %q blocks can be updated for Kafka Records", d.Id(), paramCredentials, paramRestEndpoint)
+	}
+	oldProducedRecords, _ := d.GetChange(paramProducedRecords)
+	producedRecords := oldProducedRecords.([]interface{})
+	if len(producedRecords) < len(d.Get(paramRecord).([]interface{})) {
+		restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Records %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		clusterId, err := extractKafkaClusterId(meta.(*Client), d, false)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Records %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		clusterApiKey, clusterApiSecret, err := extractClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Records %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaClusterIdSet, meta.(*Client).isKafkaMetadataSet, meta.(*Client).oauthToken)
+
+		produceRecordRequests, err := constructProduceRecordRequestsWithPartitions(d)
+		if err != nil {
+			return diag.Errorf("error updating Kafka Records %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		producedRecords, err = produceKafkaRecords(ctx, kafkaRestClient, d.Get(paramTopicName).(string), produceRecordRequests, producedRecords)
+		// Save the records that have been produced before any error, so they're not produced again
+		if setErr := d.Set(paramProducedRecords, producedRecords); setErr != nil {
+			return diag.FromErr(createDescriptiveError(setErr))
+		}
+		if err != nil {
+			return diag.Errorf("error updating Kafka Records %q: %s", d.Id(), err)
+		}
+	}
+	return kafkaRecordsRead(ctx, d, meta)
+}
+
+func kafkaRecordsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Kafka doesn't support deleting individual records, so records are only removed from TF state
+	// and will stay in the topic until they're removed by the topic's retention or compaction.
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Kafka Records %q from TF state only", d.Id()), map[string]interface{}{kafkaRecordsLoggingKey: d.Id()})
+	return nil
+}
+
+func createKafkaRecordsId(clusterId, topicName string, partition, offset int) string {
+	return fmt.Sprintf("%s/%s/%d/%d", clusterId, topicName, partition, offset)
+}
+
+func constructProduceRecordRequestsWithPartitions(d *schema.ResourceData) ([]produceRecordRequest, error) {
+	produceRecordRequests, err := constructProduceRecordRequests(d.Get(paramRecord).([]interface{}))
+	if err != nil {
+		return nil, err
+	}
+	// Partition 0 is a valid partition, so use raw config to find out whether the partition was set explicitly
+	rawRecords := d.GetRawConfig().GetAttr(paramRecord)
+	for i := range produceRecordRequests {
+		rawPartition := rawRecords.Index(cty.NumberIntVal(int64(i))).GetAttr(paramPartition)
+		if !rawPartition.IsNull() {
+			partition, _ := rawPartition.AsBigFloat().Int64()
+			produceRecordRequests[i].PartitionId = kafkarestv3.PtrInt32(int32(partition))
+		}
+	}
+	return produceRecordRequests, nil
+}
+
+func constructProduceRecordRequests(records []interface{}) ([]produceRecordRequest, error) {
+	requests := make([]produceRecordRequest, len(records))
+	for i, record := range records {
+		recordMap, ok := record.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("%s.%d: at least one of %q or %q must be set", paramRecord, i, paramKey, paramValue)
+		}
+		key, err := constructProduceRecordRequestData(recordMap[paramKey].([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("%s.%d.%s: %s", paramRecord, i, paramKey, err)
+		}
+		value, err := constructProduceRecordRequestData(recordMap[paramValue].([]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("%s.%d.%s: %s", paramRecord, i, paramValue, err)
+		}
+		if key == nil && value == nil {
+			return nil, fmt.Errorf("%s.%d: at least one of %q or %q must be set", paramRecord, i, paramKey, paramValue)
+		}
+		requests[i] = produceRecordRequest{
+			Headers: constructProduceRecordRequestHeaders(recordMap[paramHeader].([]interface{})),
+			Key:     key,
+			Value:   value,
+		}
+	}
+	return requests, nil
+}
+
+func constructProduceRecordRequestData(dataBlock []interface{}) (*produceRecordRequestData, error) {
+	if len(dataBlock) == 0 || dataBlock[0] == nil {
+		return nil, nil
+	}
+	dataMap := dataBlock[0].(map[string]interface{})
+	requestData := &produceRecordRequestData{
+		Type:                dataMap[paramType].(string),
+		SchemaId:            int32(dataMap[paramSchemaId].(int)),
+		Subject:             dataMap[paramSubject].(string),
+		SubjectNameStrategy: dataMap[paramSubjectNameStrategy].(string),
+		SchemaVersion:       int32(dataMap[paramSchemaVersion].(int)),
+	}
+	data := dataMap[paramData].(string)
+
+	isSchemaRegistrySerialized := requestData.SchemaId != 0 || requestData.Subject != "" || requestData.SubjectNameStrategy != "" || requestData.SchemaVersion != 0
+	if isSchemaRegistrySerialized {
+		if requestData.Type != "" {
+			return nil, fmt.Errorf("%q must not be set when %q, %q, %q or %q is set", paramType, paramSchemaId, paramSubject, paramSubjectNameStrategy, paramSchemaVersion)
+		}
+		if requestData.SchemaId != 0 && requestData.SchemaVersion != 0 {
+			return nil, fmt.Errorf("only one of %q or %q can be set", paramSchemaId, paramSchemaVersion)
+		}
+		if requestData.Subject != "" && requestData.SubjectNameStrategy != "" {
+			return nil, fmt.Errorf("only one of %q or %q can be set", paramSubject, paramSubjectNameStrategy)
+		}
+		// Schema Registry serialized data is always provided as JSON, for example, {"id": 1, "name": "alice"} for AVRO schemas.
+		var decodedData interface{}
+		if err := json.Unmarshal([]byte(data), &decodedData); err != nil {
+			return nil, fmt.Errorf("%q must be a valid JSON when Schema Registry serialization is used: %s", paramData, err)
+		}
+		requestData.Data = decodedData
+		return requestData, nil
+	}
+
+	switch requestData.Type {
+	case "", recordDataTypeString:
+		requestData.Type = recordDataTypeString
+		requestData.Data = data
+	case recordDataTypeJson:
+		var decodedData interface{}
+		if err := json.Unmarshal([]byte(data), &decodedData); err != nil {
+			return nil, fmt.Errorf("%q must be a valid JSON when %q is %q: %s", paramData, paramType, recordDataTypeJson, err)
+		}
+		requestData.Data = decodedData
+	case recordDataTypeBinary:
+		if _, err := base64.StdEncoding.DecodeString(data); err != nil {
+			return nil, fmt.Errorf("%q must be base64-encoded when %q is %q: %s", paramData, paramType, recordDataTypeBinary, err)
+		}
+		requestData.Data = data
+	}
+	return requestData, nil
+}
+
+func constructProduceRecordRequestHeaders(headers []interface{}) []kafkarestv3.ProduceRequestHeader {
+	requestHeaders := make([]kafkarestv3.ProduceRequestHeader, 0, len(headers))
+	for _, header := range headers {
+		headerMap := header.(map[string]interface{})
+		// Kafka REST API expects base64-encoded header values
+		encodedValue := base64.StdEncoding.EncodeToString([]byte(headerMap[paramValue].(string)))
+		requestHeaders = append(requestHeaders, kafkarestv3.ProduceRequestHeader{
+			Name:  headerMap[paramName].(string),
+			Value: *kafkarestv3.NewNullableString(&encodedValue),
+		})
+	}
+	return requestHeaders
+}
@@ -0,0 +1,369 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+var fullKafkaRecordsResourceLabel = fmt.Sprintf("confluent_kafka_records.%s", kafkaRecordsResourceLabel)
+var produceKafkaRecordPath = fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s/records", clusterId, topicName)
+
+func TestAccKafkaRecords(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	produceFirstRecordResponse, _ := ioutil.ReadFile("../testdata/kafka_records/produce_first_record.json")
+	produceFirstRecordStub := wiremock.Post(wiremock.URLPathEqualTo(produceKafkaRecordPath)).
+		InScenario(kafkaRecordsScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateKafkaFirstRecordHasBeenProduced).
+		WillReturn(
+			string(produceFirstRecordResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(produceFirstRecordStub)
+
+	produceSecondRecordResponse, _ := ioutil.ReadFile("../testdata/kafka_records/produce_second_record.json")
+	produceSecondRecordStub := wiremock.Post(wiremock.URLPathEqualTo(produceKafkaRecordPath)).
+		InScenario(kafkaRecordsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaFirstRecordHasBeenProduced).
+		WillSetStateTo(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(produceSecondRecordResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(produceSecondRecordStub)
+
+	readTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_created_kafka_topic.json")
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
+		InScenario(kafkaRecordsScenarioName).
+		WhenScenarioStateIs(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(readTopicResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// Records can't be deleted from a topic, so there is nothing to check
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckKafkaRecordsConfig(confluentCloudBaseUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "id", fmt.Sprintf("%s/%s/0/42", clusterId, topicName)),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "kafka_cluster.#", "1"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "kafka_cluster.0.id", clusterId),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "topic_name", topicName),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "rest_endpoint", mockServerUrl),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "record.#", "2"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "record.0.key.0.data", "feature-a"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "record.0.value.0.type", "JSON"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "record.0.header.#", "1"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "record.1.partition", "2"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "record.1.value.0.subject", "test_topic_name-value"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.#", "2"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.0.partition", "0"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.0.offset", "42"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.1.partition", "2"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.1.offset", "7"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "credentials.#", "1"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "credentials.0.key", kafkaApiKey),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "credentials.0.secret", kafkaApiSecret),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, produceFirstRecordStub, fmt.Sprintf("POST %s", produceKafkaRecordPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, produceSecondRecordStub, fmt.Sprintf("POST %s", produceKafkaRecordPath), expectedCountOne)
+}
+
+func TestAccKafkaRecordsPartiallyProduced(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	confluentCloudBaseUrl := ""
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	produceFirstRecordResponse, _ := ioutil.ReadFile("../testdata/kafka_records/produce_first_record.json")
+	produceFirstRecordStub := wiremock.Post(wiremock.URLPathEqualTo(produceKafkaRecordPath)).
+		InScenario(kafkaRecordsScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateKafkaFirstRecordHasBeenProduced).
+		WillReturn(
+			string(produceFirstRecordResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(produceFirstRecordStub)
+
+	// The first attempt to produce the second record fails
+	failSecondRecordStub := wiremock.Post(wiremock.URLPathEqualTo(produceKafkaRecordPath)).
+		InScenario(kafkaRecordsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaFirstRecordHasBeenProduced).
+		WillSetStateTo(scenarioStateKafkaSecondRecordHasFailed).
+		WillReturn(
+			`{"error_code": 40401, "message": "Schema not found"}`,
+			contentTypeJSONHeader,
+			http.StatusBadRequest,
+		)
+	_ = wiremockClient.StubFor(failSecondRecordStub)
+
+	produceSecondRecordResponse, _ := ioutil.ReadFile("../testdata/kafka_records/produce_second_record.json")
+	produceSecondRecordStub := wiremock.Post(wiremock.URLPathEqualTo(produceKafkaRecordPath)).
+		InScenario(kafkaRecordsScenarioName).
+		WhenScenarioStateIs(scenarioStateKafkaSecondRecordHasFailed).
+		WillSetStateTo(scenarioStateTopicHasBeenCreated).
+		WillReturn(
+			string(produceSecondRecordResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		)
+	_ = wiremockClient.StubFor(produceSecondRecordStub)
+
+	readTopicResponse, _ := ioutil.ReadFile("../testdata/kafka_topic/read_created_kafka_topic.json")
+	for _, scenarioState := range []string{scenarioStateKafkaSecondRecordHasFailed, scenarioStateTopicHasBeenCreated} {
+		_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(kafkaTopicPath)).
+			InScenario(kafkaRecordsScenarioName).
+			WhenScenarioStateIs(scenarioState).
+			WillReturn(
+				string(readTopicResponse),
+				contentTypeJSONHeader,
+				http.StatusOK,
+			))
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		// Records can't be deleted from a topic, so there is nothing to check
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				// Only the first record is produced, and it's saved to TF state
+				Config: testAccCheckKafkaRecordsConfig(confluentCloudBaseUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "id", fmt.Sprintf("%s/%s/0/42", clusterId, topicName)),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "record.#", "2"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.#", "1"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.0.partition", "0"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.0.offset", "42"),
+				),
+				// The remaining record is planned to be produced
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Only the remaining record is produced
+				Config: testAccCheckKafkaRecordsConfig(confluentCloudBaseUrl, mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "id", fmt.Sprintf("%s/%s/0/42", clusterId, topicName)),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.#", "2"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.0.partition", "0"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.0.offset", "42"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.1.partition", "2"),
+					resource.TestCheckResourceAttr(fullKafkaRecordsResourceLabel, "produced_records.1.offset", "7"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, produceFirstRecordStub, fmt.Sprintf("POST %s", produceKafkaRecordPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, failSecondRecordStub, fmt.Sprintf("POST %s", produceKafkaRecordPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, produceSecondRecordStub, fmt.Sprintf("POST %s", produceKafkaRecordPath), expectedCountOne)
+}
+
+func testAccCheckKafkaRecordsConfig(confluentCloudBaseUrl, mockServerUrl string) string {
+	return fmt.Sprintf(`
+    provider "confluent" {
+      endpoint = "%s"
+    }
+    resource "confluent_kafka_records" "%s" {
+      kafka_cluster {
+        id = "%s"
+      }
+
+      topic_name    = "%s"
+      rest_endpoint = "%s"
+
+      record {
+        key {
+          data = "feature-a"
+        }
+        value {
+          type = "JSON"
+          data = jsonencode({ enabled = true })
+        }
+        header {
+          name  = "source"
+          value = "terraform"
+        }
+      }
+
+      record {
+        partition = 2
+        key {
+          data = "feature-b"
+        }
+        value {
+          subject = "%s-value"
+          data    = jsonencode({ enabled = false })
+        }
+      }
+
+      credentials {
+        key = "%s"
+        secret = "%s"
+      }
+    }
+    `, confluentCloudBaseUrl, kafkaRecordsResourceLabel, clusterId, topicName, mockServerUrl, topicName, kafkaApiKey, kafkaApiSecret)
+}
+
+func TestConstructProduceRecordRequestData(t *testing.T) {
+	dataBlock := func(fields map[string]interface{}) []interface{} {
+		dataMap := map[string]interface{}{
+			paramType:                "",
+			paramData:                "",
+			paramSchemaId:            0,
+			paramSubject:             "",
+			paramSubjectNameStrategy: "",
+			paramSchemaVersion:       0,
+		}
+		for k, v := range fields {
+			dataMap[k] = v
+		}
+		return []interface{}{dataMap}
+	}
+
+	tests := []struct {
+		name         string
+		dataBlock    []interface{}
+		expectedJson string
+		expectError  bool
+	}{
+		{
+			name:         "empty block",
+			dataBlock:    []interface{}{},
+			expectedJson: "null",
+		},
+		{
+			name:         "string by default",
+			dataBlock:    dataBlock(map[string]interface{}{paramData: "alice"}),
+			expectedJson: `{"type":"STRING","data":"alice"}`,
+		},
+		{
+			name:         "json",
+			dataBlock:    dataBlock(map[string]interface{}{paramType: recordDataTypeJson, paramData: `{"id":1}`}),
+			expectedJson: `{"type":"JSON","data":{"id":1}}`,
+		},
+		{
+			name:        "invalid json",
+			dataBlock:   dataBlock(map[string]interface{}{paramType: recordDataTypeJson, paramData: `{"id":`}),
+			expectError: true,
+		},
+		{
+			name:         "binary",
+			dataBlock:    dataBlock(map[string]interface{}{paramType: recordDataTypeBinary, paramData: "YWxpY2U="}),
+			expectedJson: `{"type":"BINARY","data":"YWxpY2U="}`,
+		},
+		{
+			name:        "invalid binary",
+			dataBlock:   dataBlock(map[string]interface{}{paramType: recordDataTypeBinary, paramData: "alice!"}),
+			expectError: true,
+		},
+		{
+			name:         "schema id",
+			dataBlock:    dataBlock(map[string]interface{}{paramSchemaId: 100001, paramData: `{"id":1}`}),
+			expectedJson: `{"data":{"id":1},"schema_id":100001}`,
+		},
+		{
+			name:         "subject and version",
+			dataBlock:    dataBlock(map[string]interface{}{paramSubject: "orders-value", paramSchemaVersion: 3, paramData: `{"id":1}`}),
+			expectedJson: `{"data":{"id":1},"subject":"orders-value","schema_version":3}`,
+		},
+		{
+			name:        "type with schema",
+			dataBlock:   dataBlock(map[string]interface{}{paramType: recordDataTypeJson, paramSchemaId: 100001, paramData: `{"id":1}`}),
+			expectError: true,
+		},
+		{
+			name:        "schema id with version",
+			dataBlock:   dataBlock(map[string]interface{}{paramSchemaId: 100001, paramSchemaVersion: 3, paramData: `{"id":1}`}),
+			expectError: true,
+		},
+		{
+			name:        "subject with subject name strategy",
+			dataBlock:   dataBlock(map[string]interface{}{paramSubject: "orders-value", paramSubjectNameStrategy: "TOPIC_NAME", paramData: `{"id":1}`}),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestData, err := constructProduceRecordRequestData(tt.dataBlock)
+			if tt.expectError {
+				if err == nil {
+					t.Fatalf("expected an error, got %#v", requestData)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			actualJson, _ := json.Marshal(requestData)
+			if string(actualJson) != tt.expectedJson {
+				t.Fatalf("expected %s, got %s", tt.expectedJson, actualJson)
+			}
+		})
+	}
+}
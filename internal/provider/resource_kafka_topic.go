@@ -19,7 +19,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -27,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/samber/lo"
 
 	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
 )
@@ -44,6 +48,73 @@ var ignoredTopicSettings = []string{
 	"confluent.topic.type",
 }
 
+// Consumer group states with members that might be consuming from a topic
+var activeConsumerGroupStates = []string{"STABLE", "PREPARING_REBALANCE", "COMPLETING_REBALANCE"}
+
+const (
+	topicSettingTypeBoolean = "BOOLEAN"
+	topicSettingTypeInt     = "INT"
+	topicSettingTypeLong    = "LONG"
+	topicSettingTypeList    = "LIST"
+	topicSettingTypeString  = "STRING"
+)
+
+// topicSettingSpec describes the type and limits of a topic setting.
+type topicSettingSpec struct {
+	Type          string
+	AllowedValues []string
+	Min           *int64
+	// Max limits the value for all cluster types, unless it's overridden in MaxPerClusterType.
+	Max               *int64
+	MaxPerClusterType map[string]int64
+	IsEditable        bool
+}
+
+var topicSubjectNameStrategies = []string{"io.confluent.kafka.serializers.subject.TopicNameStrategy",
+	"io.confluent.kafka.serializers.subject.RecordNameStrategy", "io.confluent.kafka.serializers.subject.TopicRecordNameStrategy"}
+
+// Types and limits of editable topic settings, editable topic settings without an entry are plain strings.
+// https://docs.confluent.io/cloud/current/client-apps/topics/manage.html#ak-topic-configurations-for-all-ccloud-cluster-types
+var editableTopicSettingSpecs = map[string]topicSettingSpec{
+	"cleanup.policy":                        {Type: topicSettingTypeList, AllowedValues: []string{"delete", "compact"}},
+	"delete.retention.ms":                   {Type: topicSettingTypeLong, Min: kafkarestv3.PtrInt64(0), Max: kafkarestv3.PtrInt64(60566400000)},
+	"max.message.bytes":                     {Type: topicSettingTypeInt, Min: kafkarestv3.PtrInt64(0), Max: kafkarestv3.PtrInt64(8388608), MaxPerClusterType: map[string]int64{kafkaClusterTypeDedicated: 20971520}},
+	"max.compaction.lag.ms":                 {Type: topicSettingTypeLong, Min: kafkarestv3.PtrInt64(21600000)},
+	"message.timestamp.difference.max.ms":   {Type: topicSettingTypeLong, Min: kafkarestv3.PtrInt64(0)},
+	"message.timestamp.before.max.ms":       {Type: topicSettingTypeLong, Min: kafkarestv3.PtrInt64(0)},
+	"message.timestamp.after.max.ms":        {Type: topicSettingTypeLong, Min: kafkarestv3.PtrInt64(0)},
+	"message.timestamp.type":                {Type: topicSettingTypeString, AllowedValues: []string{"CreateTime", "LogAppendTime"}},
+	"min.compaction.lag.ms":                 {Type: topicSettingTypeLong, Min: kafkarestv3.PtrInt64(0)},
+	"min.insync.replicas":                   {Type: topicSettingTypeInt, Min: kafkarestv3.PtrInt64(1), Max: kafkarestv3.PtrInt64(2)},
+	"retention.bytes":                       {Type: topicSettingTypeLong, Min: kafkarestv3.PtrInt64(-1)},
+	"retention.ms":                          {Type: topicSettingTypeLong, Min: kafkarestv3.PtrInt64(-1)},
+	"segment.bytes":                         {Type: topicSettingTypeInt, Min: kafkarestv3.PtrInt64(52428800), Max: kafkarestv3.PtrInt64(1073741824)},
+	"segment.ms":                            {Type: topicSettingTypeLong, Min: kafkarestv3.PtrInt64(600000)},
+	"confluent.key.schema.validation":       {Type: topicSettingTypeBoolean},
+	"confluent.value.schema.validation":     {Type: topicSettingTypeBoolean},
+	"confluent.key.subject.name.strategy":   {Type: topicSettingTypeString, AllowedValues: topicSubjectNameStrategies},
+	"confluent.value.subject.name.strategy": {Type: topicSettingTypeString, AllowedValues: topicSubjectNameStrategies},
+}
+
+// topicSettingsCatalogue contains all topic settings known to TF Provider: editable ones and read-only ones.
+var topicSettingsCatalogue = buildTopicSettingsCatalogue()
+
+func buildTopicSettingsCatalogue() map[string]topicSettingSpec {
+	catalogue := make(map[string]topicSettingSpec)
+	for _, topicSettingName := range editableTopicSettings {
+		spec, ok := editableTopicSettingSpecs[topicSettingName]
+		if !ok {
+			spec = topicSettingSpec{Type: topicSettingTypeString}
+		}
+		spec.IsEditable = true
+		catalogue[topicSettingName] = spec
+	}
+	for _, topicSettingName := range ignoredTopicSettings {
+		catalogue[topicSettingName] = topicSettingSpec{Type: topicSettingTypeString, IsEditable: false}
+	}
+	return catalogue
+}
+
 func extractConfigs(configs map[string]interface{}) []kafkarestv3.CreateTopicRequestDataConfigs {
 	configResult := make([]kafkarestv3.CreateTopicRequestDataConfigs, len(configs))
 
@@ -94,6 +165,7 @@ func kafkaTopicResource() *schema.Resource {
 			paramRestEndpoint: {
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
 				Description:  "The REST endpoint of the Kafka cluster (e.g., `https://pkc-00000.us-central1.gcp.confluent.cloud:443`).",
 				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
 			},
@@ -106,7 +178,38 @@ func kafkaTopicResource() *schema.Resource {
 				Computed:    true,
 				Description: "The custom topic settings to set (e.g., `\"cleanup.policy\" = \"compact\"`).",
 			},
+			paramRestEndpointConnectionType: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{paramRestEndpoint},
+				ValidateFunc:  validation.StringIsNotEmpty,
+				Description:   "The connection type of the REST endpoint to look up for the Kafka cluster when `rest_endpoint` isn't set, for example, `PRIVATE_LINK`.",
+			},
 			paramCredentials: credentialsSchema(),
+			paramRecreateOnIncompatibleChange: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     paramRecreateOnIncompatibleChangeDefaultValue,
+				Description: "Controls whether a topic should be recreated when it can't be updated in-place, for example, when `partitions_count` is decreased.",
+			},
+			paramCheckActiveConsumerGroups: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     paramCheckActiveConsumerGroupsDefaultValue,
+				Description: "Controls whether a topic should not be recreated or deleted while consumer groups are actively consuming from it.",
+			},
+			paramDeletionProtection: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     paramDeletionProtectionDefaultValue,
+				Description: "Controls whether a topic can be deleted or recreated.",
+			},
+			paramPreventDestroyIfNotEmpty: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     paramPreventDestroyIfNotEmptyDefaultValue,
+				Description: "Controls whether a topic should not be deleted or recreated while any of its partitions contains records.",
+			},
 		},
 		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
@@ -127,11 +230,272 @@ func kafkaTopicResource() *schema.Resource {
 				// if it is decreased.
 				return new.(int) < old.(int)
 			}),
-			customdiff.Sequence(resourceCredentialBlockValidationWithOAuth),
+			customdiff.Sequence(resourceCredentialBlockValidationWithOAuth, kafkaTopicConfigsCustomizeDiff, kafkaTopicRecreateCustomizeDiff, restEndpointConnectionTypeCustomizeDiff),
 		),
 	}
 }
 
+// restEndpointConnectionTypeCustomizeDiff plans to look up the REST endpoint again when paramRestEndpointConnectionType changes.
+func restEndpointConnectionTypeCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange(paramRestEndpointConnectionType) || diff.Get(paramRestEndpointConnectionType).(string) == "" {
+		return nil
+	}
+	return diff.SetNewComputed(paramRestEndpoint)
+}
+
+// kafkaTopicConfigsCustomizeDiff validates topic settings against topicSettingsCatalogue during `terraform plan`
+// instead of failing in the middle of `terraform apply`.
+func kafkaTopicConfigsCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown(paramConfigs) {
+		// Topic settings depend on other resources, so they will be validated by Kafka REST API
+		return nil
+	}
+	isCreate := diff.Id() == ""
+	// Topic settings that can't be updated in-place can be set on a new topic instead
+	canBeSetOnNewTopic := isCreate || diff.Get(paramRecreateOnIncompatibleChange).(bool)
+	oldConfigs, newConfigs := diff.GetChange(paramConfigs)
+	oldTopicSettingsMap := convertToStringStringMap(oldConfigs.(map[string]interface{}))
+	newTopicSettingsMap := convertToStringStringMap(newConfigs.(map[string]interface{}))
+
+	// The Kafka cluster type is only looked up when a value is valid for some cluster types only
+	var clusterType *string
+	// Sort topic setting names to return the same error on every run
+	newTopicSettingNames := lo.Keys(newTopicSettingsMap)
+	sort.Strings(newTopicSettingNames)
+	for _, topicSettingName := range newTopicSettingNames {
+		newTopicSettingValue := newTopicSettingsMap[topicSettingName]
+		oldTopicSettingValue, ok := oldTopicSettingsMap[topicSettingName]
+		if !isCreate && ok && oldTopicSettingValue == newTopicSettingValue {
+			continue
+		}
+		if err := validateTopicSetting(topicSettingName, newTopicSettingValue, canBeSetOnNewTopic, ""); err != nil {
+			return fmt.Errorf("invalid %s[%q]: %s", paramConfigs, topicSettingName, err)
+		}
+		if !isTopicSettingValueLimitedByClusterType(topicSettingName, newTopicSettingValue) {
+			continue
+		}
+		if clusterType == nil {
+			clusterType = lo.ToPtr(lookupKafkaClusterTypeOfTopic(ctx, diff, meta))
+		}
+		if err := validateTopicSetting(topicSettingName, newTopicSettingValue, canBeSetOnNewTopic, *clusterType); err != nil {
+			return fmt.Errorf("invalid %s[%q]: %s", paramConfigs, topicSettingName, err)
+		}
+	}
+
+	if isCreate {
+		return nil
+	}
+	oldTopicSettingNames := lo.Keys(oldTopicSettingsMap)
+	sort.Strings(oldTopicSettingNames)
+	for _, topicSettingName := range oldTopicSettingNames {
+		if _, ok := newTopicSettingsMap[topicSettingName]; ok {
+			continue
+		}
+		if spec, ok := topicSettingsCatalogue[topicSettingName]; !canBeSetOnNewTopic && (!ok || !spec.IsEditable) {
+			return fmt.Errorf("invalid %s[%q]: topic setting is read-only and cannot be reset or removed. "+
+				"Set %s = true to recreate the topic instead. Read %s for more details", paramConfigs, topicSettingName, paramRecreateOnIncompatibleChange, docsUrl)
+		}
+	}
+	return nil
+}
+
+// kafkaTopicRecreateCustomizeDiff plans to recreate a topic when topic settings that are not editable are added,
+// updated, or removed and it's requested via paramRecreateOnIncompatibleChange.
+func kafkaTopicRecreateCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	shouldRecreateOnIncompatibleChange := diff.Get(paramRecreateOnIncompatibleChange).(bool)
+
+	// "partitions_count" can only increase in-place, so the topic is always recreated if it is decreased
+	oldPartitionsCount, newPartitionsCount := diff.GetChange(paramPartitionsCount)
+	isPartitionsCountDecreased := diff.NewValueKnown(paramPartitionsCount) && newPartitionsCount.(int) < oldPartitionsCount.(int)
+
+	var incompatibleTopicSettings []string
+	if diff.NewValueKnown(paramConfigs) {
+		oldConfigs, newConfigs := diff.GetChange(paramConfigs)
+		incompatibleTopicSettings = findNonEditableTopicSettingChanges(convertToStringStringMap(oldConfigs.(map[string]interface{})), convertToStringStringMap(newConfigs.(map[string]interface{})))
+	}
+	if shouldRecreateOnIncompatibleChange && len(incompatibleTopicSettings) > 0 {
+		// kafkaTopicConfigsCustomizeDiff returns an error for these changes otherwise
+		if err := diff.ForceNew(paramConfigs); err != nil {
+			return err
+		}
+	}
+
+	isRecreated := isPartitionsCountDecreased || (shouldRecreateOnIncompatibleChange && len(incompatibleTopicSettings) > 0) ||
+		diff.HasChange(paramTopicName) || diff.HasChange(paramKafkaCluster)
+	// The old value is enforced by kafkaTopicDelete, so fail early instead of during `terraform apply`.
+	// paramCheckActiveConsumerGroups and paramPreventDestroyIfNotEmpty are only checked by kafkaTopicDelete
+	// to avoid calling Kafka REST API during `terraform plan`.
+	if isDeletionProtected, _ := diff.GetChange(paramDeletionProtection); isRecreated && isDeletionProtected.(bool) {
+		return fmt.Errorf("error updating Kafka Topic %q: the topic needs to be recreated but %s is enabled. "+
+			"Set %s = false and run `terraform apply` before recreating the topic", diff.Id(), paramDeletionProtection, paramDeletionProtection)
+	}
+	return nil
+}
+
+// findNonEditableTopicSettingChanges returns the names of topic settings that are added, updated, or removed
+// but can't be updated in-place.
+func findNonEditableTopicSettingChanges(oldTopicSettingsMap, newTopicSettingsMap map[string]string) []string {
+	var topicSettingNames []string
+	for topicSettingName, newTopicSettingValue := range newTopicSettingsMap {
+		if oldTopicSettingValue, ok := oldTopicSettingsMap[topicSettingName]; ok && oldTopicSettingValue == newTopicSettingValue {
+			continue
+		}
+		if !stringInSlice(topicSettingName, editableTopicSettings, false) {
+			topicSettingNames = append(topicSettingNames, topicSettingName)
+		}
+	}
+	for topicSettingName := range oldTopicSettingsMap {
+		if _, ok := newTopicSettingsMap[topicSettingName]; ok {
+			continue
+		}
+		if !stringInSlice(topicSettingName, editableTopicSettings, false) {
+			topicSettingNames = append(topicSettingNames, topicSettingName)
+		}
+	}
+	sort.Strings(topicSettingNames)
+	return topicSettingNames
+}
+
+// listActiveConsumerGroupsOfKafkaTopic returns IDs of consumer groups that have members consuming from the topic.
+func listActiveConsumerGroupsOfKafkaTopic(ctx context.Context, c *KafkaRestClient, topicName string) ([]string, error) {
+	consumerGroups, resp, err := c.apiClient.ConsumerGroupV3Api.ListKafkaConsumerGroups(c.apiContext(ctx), c.clusterId).Execute()
+	if err != nil {
+		return nil, createDescriptiveError(err, resp)
+	}
+	var activeConsumerGroups []string
+	for _, consumerGroup := range consumerGroups.Data {
+		if !stringInSlice(consumerGroup.State, activeConsumerGroupStates, false) {
+			continue
+		}
+		consumerLags, resp, err := c.apiClient.ConsumerGroupV3Api.ListKafkaConsumerLags(c.apiContext(ctx), c.clusterId, consumerGroup.ConsumerGroupId).Execute()
+		if err != nil {
+			return nil, createDescriptiveError(err, resp)
+		}
+		for _, consumerLag := range consumerLags.Data {
+			if consumerLag.TopicName == topicName && consumerLag.ConsumerId != "" {
+				activeConsumerGroups = append(activeConsumerGroups, consumerGroup.ConsumerGroupId)
+				break
+			}
+		}
+	}
+	sort.Strings(activeConsumerGroups)
+	return activeConsumerGroups, nil
+}
+
+// validateTopicSetting validates the value of a topic setting for clusterType, or for any cluster type if clusterType is unknown.
+// Topic settings unknown to TF Provider can only be set when a topic is created.
+func validateTopicSetting(name, value string, isCreate bool, clusterType string) error {
+	spec, ok := topicSettingsCatalogue[name]
+	if !ok {
+		if isCreate {
+			return nil
+		}
+		return fmt.Errorf("topic setting is read-only and cannot be updated. Read %s for more details", docsUrl)
+	}
+	if !spec.IsEditable {
+		return fmt.Errorf("topic setting is read-only and cannot be set. Read %s for more details", docsUrl)
+	}
+
+	switch spec.Type {
+	case topicSettingTypeBoolean:
+		if !strings.EqualFold(value, "true") && !strings.EqualFold(value, "false") {
+			return fmt.Errorf("expected %q or %q, got %q", "true", "false", value)
+		}
+	case topicSettingTypeInt, topicSettingTypeLong:
+		bitSize := 64
+		if spec.Type == topicSettingTypeInt {
+			bitSize = 32
+		}
+		number, err := strconv.ParseInt(value, 10, bitSize)
+		if err != nil {
+			return fmt.Errorf("expected an integer, got %q", value)
+		}
+		if spec.Min != nil && number < *spec.Min {
+			return fmt.Errorf("expected a value of at least %d, got %d", *spec.Min, number)
+		}
+		if maxValue := spec.maxForClusterType(clusterType); maxValue != nil && number > *maxValue {
+			if clusterType != "" {
+				return fmt.Errorf("expected a value of at most %d for %s Kafka clusters, got %d", *maxValue, clusterType, number)
+			}
+			return fmt.Errorf("expected a value of at most %d, got %d", *maxValue, number)
+		}
+	case topicSettingTypeList:
+		for _, item := range strings.Split(value, ",") {
+			item = strings.TrimSpace(item)
+			if !stringInSlice(item, spec.AllowedValues, false) {
+				return fmt.Errorf("expected a comma-separated list of %s, got %q", strings.Join(spec.AllowedValues, ", "), value)
+			}
+		}
+	case topicSettingTypeString:
+		if len(spec.AllowedValues) > 0 && !stringInSlice(value, spec.AllowedValues, false) {
+			return fmt.Errorf("expected one of %s, got %q", strings.Join(spec.AllowedValues, ", "), value)
+		}
+	}
+	return nil
+}
+
+// maxForClusterType returns the max value for clusterType, or the largest max value across all cluster types
+// if clusterType is unknown. It returns nil if there is no limit.
+func (spec topicSettingSpec) maxForClusterType(clusterType string) *int64 {
+	if clusterType == "" {
+		return spec.maxForAnyClusterType()
+	}
+	if clusterTypeMaxValue, ok := spec.MaxPerClusterType[clusterType]; ok {
+		return &clusterTypeMaxValue
+	}
+	return spec.Max
+}
+
+// maxForAnyClusterType returns the largest max value across all cluster types, or nil if there is no limit.
+func (spec topicSettingSpec) maxForAnyClusterType() *int64 {
+	if spec.Max == nil {
+		return nil
+	}
+	maxValue := *spec.Max
+	for _, clusterTypeMaxValue := range spec.MaxPerClusterType {
+		if clusterTypeMaxValue > maxValue {
+			maxValue = clusterTypeMaxValue
+		}
+	}
+	return &maxValue
+}
+
+// isTopicSettingValueLimitedByClusterType returns true if the value exceeds the limit of some cluster types only.
+func isTopicSettingValueLimitedByClusterType(name, value string) bool {
+	spec := topicSettingsCatalogue[name]
+	if spec.Max == nil || len(spec.MaxPerClusterType) == 0 {
+		return false
+	}
+	number, err := strconv.ParseInt(value, 10, 64)
+	return err == nil && number > *spec.Max
+}
+
+// lookupKafkaClusterTypeOfTopic returns the type of the Kafka cluster of the topic during `terraform plan`,
+// or "" if it's unknown, for example, when the Kafka cluster is created in the same run or Cloud API Keys aren't set.
+func lookupKafkaClusterTypeOfTopic(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) string {
+	client := meta.(*Client)
+	clusterId := client.kafkaClusterId
+	if !client.isKafkaClusterIdSet {
+		clusterIdKey := fmt.Sprintf("%s.0.%s", paramKafkaCluster, paramId)
+		if !diff.NewValueKnown(clusterIdKey) {
+			return ""
+		}
+		clusterId = diff.Get(clusterIdKey).(string)
+	}
+	if clusterId == "" || (client.cloudApiKey == "" && !client.isOAuthEnabled) {
+		return ""
+	}
+	cluster, err := client.kafkaClusterCache.get(ctx, client, clusterId)
+	if err != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Skipping validation of topic settings against the type of Kafka Cluster %q: %s", clusterId, createDescriptiveError(err)))
+		return ""
+	}
+	return kafkaClusterTypeOf(cluster)
+}
+
 func extractKafkaClusterId(client *Client, d *schema.ResourceData, isImportOperation bool) (string, error) {
 	if client.isKafkaClusterIdSet {
 		return client.kafkaClusterId, nil
@@ -167,7 +531,40 @@ func extractRestEndpoint(client *Client, d *schema.ResourceData, isImportOperati
 	if restEndpoint != "" {
 		return restEndpoint, nil
 	}
-	return "", fmt.Errorf("one of provider.kafka_rest_endpoint (defaults to KAFKA_REST_ENDPOINT environment variable) or resource.rest_endpoint must be set")
+	if cluster, ok := extractKafkaClusterMetadataFromProviderBlock(client, d); ok && cluster.restEndpoint != "" {
+		return cluster.restEndpoint, nil
+	}
+	return "", fmt.Errorf("one of provider.kafka_rest_endpoint (defaults to KAFKA_REST_ENDPOINT environment variable), provider.kafka_clusters.rest_endpoint or resource.rest_endpoint must be set")
+}
+
+// extractKafkaClusterMetadataFromProviderBlock returns the entry of the kafka_clusters blocks of the provider block
+// for the Kafka cluster of the resource, if any.
+func extractKafkaClusterMetadataFromProviderBlock(client *Client, d *schema.ResourceData) (providerClusterMetadata, bool) {
+	clusterId, err := extractKafkaClusterId(client, d, false)
+	if err != nil {
+		return providerClusterMetadata{}, false
+	}
+	cluster, ok := client.kafkaRestClientFactory.clusters[clusterId]
+	return cluster, ok
+}
+
+// extractRestEndpointOrLookUp extends extractRestEndpoint for resources that support paramRestEndpointConnectionType:
+// when the REST endpoint isn't set, or paramRestEndpointConnectionType has changed, it's looked up by the Kafka cluster ID.
+func extractRestEndpointOrLookUp(ctx context.Context, client *Client, d *schema.ResourceData, isImportOperation bool) (string, error) {
+	if client.isKafkaMetadataSet || isImportOperation {
+		return extractRestEndpoint(client, d, isImportOperation)
+	}
+	if restEndpoint := d.Get(paramRestEndpoint).(string); restEndpoint != "" && !d.HasChange(paramRestEndpointConnectionType) {
+		return restEndpoint, nil
+	}
+	if cluster, ok := extractKafkaClusterMetadataFromProviderBlock(client, d); ok && cluster.restEndpoint != "" && !d.HasChange(paramRestEndpointConnectionType) {
+		return cluster.restEndpoint, nil
+	}
+	clusterId, err := extractKafkaClusterId(client, d, isImportOperation)
+	if err != nil {
+		return "", fmt.Errorf("one of provider.kafka_rest_endpoint (defaults to KAFKA_REST_ENDPOINT environment variable), provider.kafka_clusters.rest_endpoint, resource.rest_endpoint or resource.kafka_cluster.id must be set")
+	}
+	return lookupKafkaClusterRestEndpoint(ctx, client, clusterId, d.Get(paramRestEndpointConnectionType).(string))
 }
 
 func extractClusterApiKeyAndApiSecret(client *Client, d *schema.ResourceData, isImportOperation bool) (string, string, error) {
@@ -190,11 +587,14 @@ func extractClusterApiKeyAndApiSecret(client *Client, d *schema.ResourceData, is
 	if clusterApiKey != "" {
 		return clusterApiKey, clusterApiSecret, nil
 	}
-	return "", "", fmt.Errorf("one of (provider.kafka_api_key, provider.kafka_api_secret), (KAFKA_API_KEY, KAFKA_API_SECRET environment variables) or (resource.credentials.key, resource.credentials.secret) must be set")
+	if cluster, ok := extractKafkaClusterMetadataFromProviderBlock(client, d); ok && cluster.apiKey != "" {
+		return cluster.apiKey, cluster.apiSecret, nil
+	}
+	return "", "", fmt.Errorf("one of (provider.kafka_api_key, provider.kafka_api_secret), (KAFKA_API_KEY, KAFKA_API_SECRET environment variables), (provider.kafka_clusters.api_key, provider.kafka_clusters.api_secret) or (resource.credentials.key, resource.credentials.secret) must be set")
 }
 
 func kafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	restEndpoint, err := extractRestEndpointOrLookUp(ctx, meta.(*Client), d, false)
 	if err != nil {
 		return diag.Errorf("error creating Kafka Topic: %s", createDescriptiveError(err))
 	}
@@ -250,7 +650,7 @@ func executeKafkaTopicCreate(ctx context.Context, c *KafkaRestClient, requestDat
 func kafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Deleting Kafka Topic %q", d.Id()), map[string]interface{}{kafkaTopicLoggingKey: d.Id()})
 
-	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	restEndpoint, err := extractRestEndpointOrLookUp(ctx, meta.(*Client), d, false)
 	if err != nil {
 		return diag.Errorf("error deleting Kafka Topic: %s", createDescriptiveError(err))
 	}
@@ -265,6 +665,10 @@ func kafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interfac
 	kafkaRestClient := meta.(*Client).kafkaRestClientFactory.CreateKafkaRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isKafkaClusterIdSet, meta.(*Client).isKafkaMetadataSet, meta.(*Client).oauthToken)
 	topicName := d.Get(paramTopicName).(string)
 
+	if err := checkKafkaTopicCanBeDeleted(ctx, d, kafkaRestClient, topicName); err != nil {
+		return diag.Errorf("error deleting Kafka Topic %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
 	resp, err := kafkaRestClient.apiClient.TopicV3Api.DeleteKafkaTopic(kafkaRestClient.apiContext(ctx), kafkaRestClient.clusterId, topicName).Execute()
 
 	if err != nil {
@@ -280,10 +684,66 @@ func kafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interfac
 	return nil
 }
 
+// checkKafkaTopicCanBeDeleted enforces paramDeletionProtection, paramPreventDestroyIfNotEmpty and paramCheckActiveConsumerGroups
+// right before a topic is deleted, since the topic might have received records or consumers after `terraform plan`.
+func checkKafkaTopicCanBeDeleted(ctx context.Context, d *schema.ResourceData, c *KafkaRestClient, topicName string) error {
+	if d.Get(paramDeletionProtection).(bool) {
+		return fmt.Errorf("%s is enabled. Set %s = false and run `terraform apply` before deleting or recreating the topic", paramDeletionProtection, paramDeletionProtection)
+	}
+	if d.Get(paramPreventDestroyIfNotEmpty).(bool) {
+		nonEmptyPartitions, err := listNonEmptyPartitionsOfKafkaTopic(ctx, c, topicName)
+		if err != nil {
+			return fmt.Errorf("error checking whether the topic is empty: %s", createDescriptiveError(err))
+		}
+		if len(nonEmptyPartitions) > 0 {
+			return fmt.Errorf("the topic is not empty: partitions %s contain records. "+
+				"Set %s = false to delete the topic anyway", strings.Join(nonEmptyPartitions, ", "), paramPreventDestroyIfNotEmpty)
+		}
+	}
+	if d.Get(paramCheckActiveConsumerGroups).(bool) {
+		activeConsumerGroups, err := listActiveConsumerGroupsOfKafkaTopic(ctx, c, topicName)
+		if err != nil {
+			return fmt.Errorf("error listing active consumer groups: %s", createDescriptiveError(err))
+		}
+		if len(activeConsumerGroups) > 0 {
+			return fmt.Errorf("the topic is being consumed by active consumer groups: %s. "+
+				"Stop the consumers or set %s = false to delete the topic anyway", strings.Join(activeConsumerGroups, ", "), paramCheckActiveConsumerGroups)
+		}
+	}
+	return nil
+}
+
+// listNonEmptyPartitionsOfKafkaTopic returns IDs of partitions whose leader replica has records between its log start and log end offsets.
+// Kafka REST API client doesn't support the replica status endpoint, so it's called directly.
+func listNonEmptyPartitionsOfKafkaTopic(ctx context.Context, c *KafkaRestClient, topicName string) ([]string, error) {
+	var replicaStatuses kafkarestv3.ReplicaStatusDataList
+	path := fmt.Sprintf("/kafka/v3/clusters/%s/topics/%s/partitions/-/replica-status", url.PathEscape(c.clusterId), url.PathEscape(topicName))
+	resp, err := c.executeRawRequest(ctx, http.MethodGet, path, nil, &replicaStatuses)
+	if err != nil {
+		return nil, createDescriptiveError(err, resp)
+	}
+	return findNonEmptyPartitions(replicaStatuses.Data), nil
+}
+
+func findNonEmptyPartitions(replicaStatuses []kafkarestv3.ReplicaStatusData) []string {
+	var partitionIds []int
+	for _, replicaStatus := range replicaStatuses {
+		if replicaStatus.IsLeader && replicaStatus.LogEndOffset > replicaStatus.LogStartOffset {
+			partitionIds = append(partitionIds, int(replicaStatus.PartitionId))
+		}
+	}
+	sort.Ints(partitionIds)
+	nonEmptyPartitions := make([]string, len(partitionIds))
+	for i, partitionId := range partitionIds {
+		nonEmptyPartitions[i] = strconv.Itoa(partitionId)
+	}
+	return nonEmptyPartitions
+}
+
 func kafkaTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	tflog.Debug(ctx, fmt.Sprintf("Reading Kafka Topic %q", d.Id()), map[string]interface{}{kafkaTopicLoggingKey: d.Id()})
 
-	restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+	restEndpoint, err := extractRestEndpointOrLookUp(ctx, meta.(*Client), d, false)
 	if err != nil {
 		return diag.Errorf("error reading Kafka Topic: %s", createDescriptiveError(err))
 	}
@@ -302,6 +762,11 @@ func kafkaTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{
 	if err != nil {
 		return diag.Errorf("error reading Kafka Topic: %s", createDescriptiveError(err))
 	}
+	if d.Id() != "" {
+		if err := setKafkaTopicResourceDefaults(d); err != nil {
+			return diag.Errorf("error reading Kafka Topic: %s", createDescriptiveError(err))
+		}
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Finished reading Kafka Topic %q", d.Id()), map[string]interface{}{kafkaTopicLoggingKey: d.Id()})
 
@@ -421,6 +886,9 @@ func kafkaTopicImport(ctx context.Context, d *schema.ResourceData, meta interfac
 	if _, err := readTopicAndSetAttributes(ctx, d, kafkaRestClient, topicName); err != nil {
 		return nil, fmt.Errorf("error importing Kafka Topic %q: %s", d.Id(), createDescriptiveError(err))
 	}
+	if err := setKafkaTopicResourceDefaults(d); err != nil {
+		return nil, fmt.Errorf("error importing Kafka Topic %q: %s", d.Id(), createDescriptiveError(err))
+	}
 	tflog.Debug(ctx, fmt.Sprintf("Finished importing Kafka Topic %q", d.Id()), map[string]interface{}{kafkaTopicLoggingKey: d.Id()})
 	return []*schema.ResourceData{d}, nil
 }
@@ -480,9 +948,42 @@ func readTopicAndSetAttributes(ctx context.Context, d *schema.ResourceData, c *K
 	return []*schema.ResourceData{d}, nil
 }
 
+// setKafkaTopicResourceDefaults explicitly sets resource-only attributes to their default values if unset,
+// since readTopicAndSetAttributes is shared with confluent_kafka_topic data source that doesn't have them.
+func setKafkaTopicResourceDefaults(d *schema.ResourceData) error {
+	// Explicitly set paramRecreateOnIncompatibleChange to the default value if unset
+	if _, ok := d.GetOk(paramRecreateOnIncompatibleChange); !ok {
+		if err := d.Set(paramRecreateOnIncompatibleChange, paramRecreateOnIncompatibleChangeDefaultValue); err != nil {
+			return err
+		}
+	}
+
+	// Explicitly set paramCheckActiveConsumerGroups to the default value if unset
+	if _, ok := d.GetOk(paramCheckActiveConsumerGroups); !ok {
+		if err := d.Set(paramCheckActiveConsumerGroups, paramCheckActiveConsumerGroupsDefaultValue); err != nil {
+			return err
+		}
+	}
+
+	// Explicitly set paramDeletionProtection to the default value if unset
+	if _, ok := d.GetOk(paramDeletionProtection); !ok {
+		if err := d.Set(paramDeletionProtection, paramDeletionProtectionDefaultValue); err != nil {
+			return err
+		}
+	}
+
+	// Explicitly set paramPreventDestroyIfNotEmpty to the default value if unset
+	if _, ok := d.GetOk(paramPreventDestroyIfNotEmpty); !ok {
+		if err := d.Set(paramPreventDestroyIfNotEmpty, paramPreventDestroyIfNotEmptyDefaultValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials, paramConfigs, paramPartitionsCount, paramRestEndpoint) {
-		return diag.Errorf("error updating Kafka Topic %q: only %q, %q, %q and %q blocks can be updated for Kafka Topic", d.Id(), paramCredentials, paramConfigs, paramPartitionsCount, paramRestEndpoint)
+	if d.HasChangesExcept(paramCredentials, paramConfigs, paramPartitionsCount, paramRestEndpoint, paramRestEndpointConnectionType, paramRecreateOnIncompatibleChange, paramCheckActiveConsumerGroups, paramDeletionProtection, paramPreventDestroyIfNotEmpty) {
+		return diag.Errorf("error updating Kafka Topic %q: only %q, %q, %q, %q, %q, %q, %q, %q and %q blocks can be updated for Kafka Topic", d.Id(), paramCredentials, paramConfigs, paramPartitionsCount, paramRestEndpoint, paramRestEndpointConnectionType, paramRecreateOnIncompatibleChange, paramCheckActiveConsumerGroups, paramDeletionProtection, paramPreventDestroyIfNotEmpty)
 	}
 	if d.HasChange(paramPartitionsCount) {
 		oldPartitionsCount, newPartitionsCount := d.GetChange(paramPartitionsCount)
@@ -492,7 +993,7 @@ func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 		updateTopicRequest := kafkarestv3.UpdatePartitionCountRequestData{
 			PartitionsCount: newPartitionsCountInt32,
 		}
-		restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+		restEndpoint, err := extractRestEndpointOrLookUp(ctx, meta.(*Client), d, false)
 		if err != nil {
 			return diag.Errorf("error updating Kafka Topic: %s", createDescriptiveError(err))
 		}
@@ -585,7 +1086,7 @@ func kafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interfac
 		updateTopicRequest := kafkarestv3.AlterConfigBatchRequestData{
 			Data: topicSettingsUpdateBatch,
 		}
-		restEndpoint, err := extractRestEndpoint(meta.(*Client), d, false)
+		restEndpoint, err := extractRestEndpointOrLookUp(ctx, meta.(*Client), d, false)
 		if err != nil {
 			return diag.Errorf("error updating Kafka Topic: %s", createDescriptiveError(err))
 		}
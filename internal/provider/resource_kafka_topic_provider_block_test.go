@@ -149,7 +149,7 @@ func TestAccTopicWithEnhancedProviderBlock(t *testing.T) {
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "kafka_cluster.#", "1"),
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "kafka_cluster.0.id", clusterId),
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "id", fmt.Sprintf("%s/%s", clusterId, topicName)),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "%", numberOfResourceAttributes),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "%", numberOfKafkaTopicResourceAttributes),
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "topic_name", topicName),
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "partitions_count", strconv.Itoa(partitionCount)),
 					resource.TestCheckNoResourceAttr(fullTopicResourceLabel, "rest_endpoint"),
@@ -171,7 +171,7 @@ func TestAccTopicWithEnhancedProviderBlock(t *testing.T) {
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "kafka_cluster.#", "1"),
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "kafka_cluster.0.id", clusterId),
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "id", fmt.Sprintf("%s/%s", clusterId, topicName)),
-					resource.TestCheckResourceAttr(fullTopicResourceLabel, "%", numberOfResourceAttributes),
+					resource.TestCheckResourceAttr(fullTopicResourceLabel, "%", numberOfKafkaTopicResourceAttributes),
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "topic_name", topicName),
 					resource.TestCheckResourceAttr(fullTopicResourceLabel, "partitions_count", strconv.Itoa(partitionCount)),
 					resource.TestCheckNoResourceAttr(fullTopicResourceLabel, "rest_endpoint"),
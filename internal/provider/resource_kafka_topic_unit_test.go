@@ -0,0 +1,156 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	kafkarestv3 "github.com/confluentinc/ccloud-sdk-go-v2/kafkarest/v3"
+)
+
+func TestTopicSettingsCatalogueCoversEditableTopicSettings(t *testing.T) {
+	for _, topicSettingName := range editableTopicSettings {
+		spec, ok := topicSettingsCatalogue[topicSettingName]
+		if !ok {
+			t.Errorf("expected %q topic setting to be in the catalogue", topicSettingName)
+			continue
+		}
+		if !spec.IsEditable {
+			t.Errorf("expected %q topic setting to be editable", topicSettingName)
+		}
+	}
+	for topicSettingName := range editableTopicSettingSpecs {
+		if !stringInSlice(topicSettingName, editableTopicSettings, false) {
+			t.Errorf("expected %q topic setting with a spec to be in editableTopicSettings", topicSettingName)
+		}
+	}
+	for _, topicSettingName := range ignoredTopicSettings {
+		if topicSettingsCatalogue[topicSettingName].IsEditable {
+			t.Errorf("expected %q topic setting to be read-only", topicSettingName)
+		}
+	}
+}
+
+func TestValidateTopicSetting(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		isCreate    bool
+		clusterType string
+		expectError bool
+	}{
+		{name: "cleanup.policy", value: "delete", isCreate: true},
+		{name: "cleanup.policy", value: "compact,delete", isCreate: true},
+		{name: "cleanup.policy", value: "compact, delete", isCreate: true},
+		{name: "cleanup.policy", value: "compact,delte", isCreate: true, expectError: true},
+		{name: "cleanup.policy", value: "", isCreate: true, expectError: true},
+		{name: "retention.ms", value: "-1", isCreate: true},
+		{name: "retention.ms", value: "604800000", isCreate: true},
+		{name: "retention.ms", value: "-5", isCreate: true, expectError: true},
+		{name: "retention.ms", value: "7d", isCreate: true, expectError: true},
+		{name: "delete.retention.ms", value: "63113904003", isCreate: false, expectError: true},
+		{name: "max.message.bytes", value: "8388608", isCreate: false},
+		// Allowed for Dedicated clusters only, so it's validated by Kafka REST API when the cluster type is unknown
+		{name: "max.message.bytes", value: "20971520", isCreate: false},
+		{name: "max.message.bytes", value: "20971521", isCreate: false, expectError: true},
+		{name: "max.message.bytes", value: "20971520", isCreate: false, clusterType: kafkaClusterTypeDedicated},
+		{name: "max.message.bytes", value: "20971520", isCreate: false, clusterType: kafkaClusterTypeStandard, expectError: true},
+		{name: "max.message.bytes", value: "8388608", isCreate: false, clusterType: kafkaClusterTypeBasic},
+		{name: "segment.bytes", value: "1073741824", isCreate: false, clusterType: kafkaClusterTypeBasic},
+		{name: "min.insync.replicas", value: "3", isCreate: false, expectError: true},
+		{name: "segment.bytes", value: "1048576", isCreate: false, expectError: true},
+		{name: "message.timestamp.type", value: "LogAppendTime", isCreate: false},
+		{name: "message.timestamp.type", value: "AppendTime", isCreate: false, expectError: true},
+		{name: "confluent.value.schema.validation", value: "TRUE", isCreate: false},
+		{name: "confluent.value.schema.validation", value: "yes", isCreate: false, expectError: true},
+		{name: "confluent.key.subject.name.strategy", value: "io.confluent.kafka.serializers.subject.RecordNameStrategy", isCreate: false},
+		{name: "confluent.key.subject.name.strategy", value: "RecordNameStrategy", isCreate: false, expectError: true},
+		{name: "confluent.schema.validation.context.name", value: ".mycontext", isCreate: false},
+		{name: "confluent.topic.type", value: "standard", isCreate: true, expectError: true},
+		{name: "confluent.placement.constraints", value: "{}", isCreate: true},
+		{name: "confluent.placement.constraints", value: "{}", isCreate: false, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+"="+tt.value+"/"+tt.clusterType, func(t *testing.T) {
+			err := validateTopicSetting(tt.name, tt.value, tt.isCreate, tt.clusterType)
+			if tt.expectError && err == nil {
+				t.Fatalf("expected an error for %q = %q", tt.name, tt.value)
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("unexpected error for %q = %q: %s", tt.name, tt.value, err)
+			}
+		})
+	}
+}
+
+func TestIsTopicSettingValueLimitedByClusterType(t *testing.T) {
+	if isTopicSettingValueLimitedByClusterType("max.message.bytes", "8388608") {
+		t.Errorf("expected 8388608 to be allowed for all cluster types")
+	}
+	if !isTopicSettingValueLimitedByClusterType("max.message.bytes", "8388609") {
+		t.Errorf("expected 8388609 to be allowed for some cluster types only")
+	}
+	if isTopicSettingValueLimitedByClusterType("segment.bytes", "1073741825") {
+		t.Errorf("expected segment.bytes limits not to depend on the cluster type")
+	}
+}
+
+func TestFindNonEditableTopicSettingChanges(t *testing.T) {
+	oldTopicSettings := map[string]string{
+		"retention.ms":                    "6789",
+		"confluent.placement.constraints": "{}",
+		"compression.type":                "producer",
+	}
+	newTopicSettings := map[string]string{
+		"retention.ms":                    "12345",
+		"confluent.placement.constraints": "{\"version\":1}",
+		"confluent.tier.enable":           "true",
+	}
+
+	actual := findNonEditableTopicSettingChanges(oldTopicSettings, newTopicSettings)
+	expected := []string{"compression.type", "confluent.placement.constraints", "confluent.tier.enable"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+
+	if actual := findNonEditableTopicSettingChanges(oldTopicSettings, oldTopicSettings); len(actual) != 0 {
+		t.Fatalf("expected no changes, got %v", actual)
+	}
+}
+
+func TestFindNonEmptyPartitions(t *testing.T) {
+	replicaStatuses := []kafkarestv3.ReplicaStatusData{
+		{PartitionId: 2, BrokerId: 1, IsLeader: true, LogStartOffset: 0, LogEndOffset: 10},
+		{PartitionId: 2, BrokerId: 2, IsLeader: false, LogStartOffset: 0, LogEndOffset: 10},
+		// Records were removed by retention
+		{PartitionId: 0, BrokerId: 1, IsLeader: true, LogStartOffset: 42, LogEndOffset: 42},
+		// Only leader replicas are taken into account
+		{PartitionId: 1, BrokerId: 2, IsLeader: false, LogStartOffset: 0, LogEndOffset: 3},
+		{PartitionId: 1, BrokerId: 3, IsLeader: true, LogStartOffset: 0, LogEndOffset: 0},
+		{PartitionId: 10, BrokerId: 3, IsLeader: true, LogStartOffset: 5, LogEndOffset: 6},
+	}
+
+	actual := findNonEmptyPartitions(replicaStatuses)
+	expected := []string{"2", "10"}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+
+	if actual := findNonEmptyPartitions(nil); len(actual) != 0 {
+		t.Fatalf("expected no partitions, got %v", actual)
+	}
+}
@@ -56,12 +56,14 @@ func schemaResource() *schema.Resource {
 			},
 			paramCredentials: credentialsSchema(),
 			paramSubjectName: {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				Description:  "The name of the Schema Registry Subject.",
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The name of the Schema Registry Subject.",
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: subjectNameDiffSuppressFunc,
 			},
+			paramContext: subjectContextSchema(),
 			paramFormat: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -75,6 +77,8 @@ func schemaResource() *schema.Resource {
 				Optional:     true,
 				Description:  "The definition of the Schema.",
 				ValidateFunc: validation.StringIsNotEmpty,
+				// Formatting differences between a schema file and the definition that Schema Registry returns are ignored
+				DiffSuppressFunc: schemaDiffSuppressFunc,
 			},
 			paramVersion: {
 				Type:        schema.TypeInt,
@@ -132,8 +136,25 @@ func schemaResource() *schema.Resource {
 				Default:     paramSkipValidationDuringPlanDefaultValue,
 				Description: "Controls whether a schema validation should be skipped during terraform plan.",
 			},
+			paramValidateLocally: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Controls whether a schema should be parsed and validated by the provider itself during terraform plan, without calling Schema Registry.",
+			},
+			paramDeletionProtection: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     paramDeletionProtectionDefaultValue,
+				Description: "Controls whether a schema can be deleted or recreated.",
+			},
+			paramRestoreSoftDeleted: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     paramRestoreSoftDeletedDefaultValue,
+				Description: "Controls whether a soft deleted version of the same schema should be restored with its original version and ID instead of registering a new version.",
+			},
 		},
-		CustomizeDiff: customdiff.Sequence(SetSchemaDiff),
+		CustomizeDiff: customdiff.Sequence(schemaRulesetCustomizeDiff, SetSchemaDiff, schemaRegistryDeletionProtectionCustomizeDiff("Schema", paramSchemaRegistryCluster, paramRestEndpoint, paramSubjectName, paramContext, paramFormat)),
 	}
 }
 
@@ -276,6 +297,27 @@ func SetSchemaDiff(ctx context.Context, diff *schema.ResourceDiff, meta interfac
 	oldSchema := oldObj.(string)
 	newSchema := newObj.(string)
 
+	// Local validation runs before any Schema Registry metadata is required, so that it works
+	// even when Schema Registry isn't reachable during 'terraform plan'
+	shouldValidateLocally := diff.Get(paramValidateLocally).(bool) && diff.NewValueKnown(paramSchema) && diff.NewValueKnown(paramSchemaReference)
+	if shouldValidateLocally {
+		if err := validateSchemaLocally(ctx, diff.Get(paramFormat).(string), newSchema, extractSchemaReferenceNames(diff.Get(paramSchemaReference))); err != nil {
+			return fmt.Errorf("error validating Schema locally: %s", createDescriptiveError(err))
+		}
+	}
+
+	// SetSchemaDiff() function is invoked during terraform plan
+	// Having schema validation check during plan empowers customers to review schema changes before applying
+	// paramSkipValidationDuringPlan = true -> skipping schema validation during 'terraform plan'
+	// Regardless of paramSkipValidationDuringPlan 'true' or 'false',
+	// schema validation check still takes place during 'terraform apply'
+	skipSchemaValidateDuringPlan := diff.Get(paramSkipValidationDuringPlan).(bool)
+	// Combined with paramValidateLocally = true, no Schema Registry requests are sent during 'terraform plan' at all,
+	// so that it works even when Schema Registry isn't reachable from where 'terraform plan' runs
+	if skipSchemaValidateDuringPlan && diff.Get(paramValidateLocally).(bool) {
+		return schemaRecreateOnUpdateCheck(diff)
+	}
+
 	client := meta.(*Client)
 
 	var restEndpoint, clusterId, clusterApiKey, clusterApiSecret string
@@ -305,9 +347,21 @@ func SetSchemaDiff(ctx context.Context, diff *schema.ResourceDiff, meta interfac
 
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
 
-	subjectName := diff.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(diff.Get(paramContext).(string), diff.Get(paramSubjectName).(string))
 	format := diff.Get(paramFormat).(string)
 	schemaContent := newSchema
+
+	// Differences that Schema Registry normalizes away for subjects with normalization enabled don't register a new version either.
+	// Like any other Schema Registry request during 'terraform plan', it's skipped when planning offline
+	if diff.Id() != "" && diff.NewValueKnown(paramSchema) && diff.NewValueKnown(paramSchemaReference) {
+		isEquivalent, err := schemaNormalizeCheck(ctx, diff, schemaRegistryRestClient, subjectName, oldSchema, newSchema)
+		if err != nil {
+			return err
+		}
+		if isEquivalent {
+			return nil
+		}
+	}
 	schemaReferences := buildSchemaReferences(diff.Get(paramSchemaReference).(*schema.Set).List())
 
 	createSchemaRequest := schemaregistryv1.NewRegisterSchemaRequest()
@@ -353,15 +407,16 @@ func SetSchemaDiff(ctx context.Context, diff *schema.ResourceDiff, meta interfac
 		createSchemaRequest.SetMetadata(*metadata)
 	}
 
-	// SetSchemaDiff() function is invoked during terraform plan
-	// Having schema validation check during plan empowers customers to review schema changes before applying
-	// paramSkipValidationDuringPlan = true -> skipping schema validation during 'terraform plan'
-	// Regardless of paramSkipValidationDuringPlan 'true' or 'false',
-	// schema validation check still takes place during 'terraform apply'
-	skipSchemaValidateDuringPlan := diff.Get(paramSkipValidationDuringPlan).(bool)
+	// The local compatibility check needs the compatibility level of the subject, which is why it runs
+	// only once Schema Registry metadata is available. It complements the remote check below by listing every incompatibility.
+	if shouldValidateLocally && diff.Id() != "" && oldSchema != "" {
+		if err := schemaLocalCompatibilityCheck(ctx, diff, schemaRegistryRestClient, subjectName, oldSchema, newSchema); err != nil {
+			return err
+		}
+	}
+
 	if !skipSchemaValidateDuringPlan {
-		err := schemaValidateCheck(ctx, schemaRegistryRestClient, createSchemaRequest, subjectName)
-		if err != nil {
+		if err := schemaValidateCheck(ctx, schemaRegistryRestClient, createSchemaRequest, subjectName); err != nil {
 			return err
 		}
 	}
@@ -380,9 +435,18 @@ func SetSchemaDiff(ctx context.Context, diff *schema.ResourceDiff, meta interfac
 		return err
 	}
 
-	// Return an error for a schema update when recreate_on_update=true
-	// User wants to edit / evolve a schema. See https://docs.confluent.io/cloud/current/sr/schemas-manage.html#editing-schemas for more details.
-	// This is a fix for https://github.com/confluentinc/terraform-provider-confluent/issues/235
+	return schemaRecreateOnUpdateCheck(diff)
+}
+
+// schemaRecreateOnUpdateCheck returns an error for a schema update when recreate_on_update=true
+// User wants to edit / evolve a schema. See https://docs.confluent.io/cloud/current/sr/schemas-manage.html#editing-schemas for more details.
+// This is a fix for https://github.com/confluentinc/terraform-provider-confluent/issues/235
+func schemaRecreateOnUpdateCheck(diff *schema.ResourceDiff) error {
+	// Skip the check if the schema doesn't exist yet
+	if diff.Id() == "" {
+		return nil
+	}
+
 	shouldRecreateOnUpdate := diff.Get(paramRecreateOnUpdate).(bool)
 	hasSemanticSchemaUpdate := diff.HasChange(paramSchema)
 
@@ -477,6 +541,54 @@ func schemaValidateCheck(ctx context.Context, c *SchemaRegistryRestClient, creat
 	return nil
 }
 
+// schemaNormalizeCheck suppresses the differences that are only ignored for subjects with normalization enabled.
+// The other differences that don't change the schema have already been suppressed by schemaDiffSuppressFunc,
+// so the normalization setting is only read from Schema Registry when the schemas are equivalent once normalized.
+func schemaNormalizeCheck(ctx context.Context, diff *schema.ResourceDiff, c *SchemaRegistryRestClient, subjectName, oldSchema, newSchema string) (bool, error) {
+	if !areSchemasEquivalent(diff.Get(paramFormat).(string), oldSchema, newSchema, extractSchemaReferenceNames(diff.Get(paramSchemaReference)), true) {
+		return false, nil
+	}
+	subjectConfig, resp, err := executeSubjectConfigReadWithDefaultToGlobal(ctx, c, subjectName)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Skipping normalization check for Schema %q: error reading normalization setting of Subject %q: %s", diff.Id(), subjectName, createDescriptiveError(err, resp)), map[string]interface{}{schemaLoggingKey: diff.Id()})
+		return false, nil
+	}
+	if !subjectConfig.GetNormalize() {
+		return false, nil
+	}
+	if err := diff.SetNew(paramSchema, oldSchema); err != nil {
+		return false, fmt.Errorf("error customizing diff Schema: %s", createDescriptiveError(err))
+	}
+	return true, nil
+}
+
+func schemaLocalCompatibilityCheck(ctx context.Context, diff *schema.ResourceDiff, c *SchemaRegistryRestClient, subjectName, oldSchema, newSchema string) error {
+	subjectConfig, resp, err := executeSubjectConfigReadWithDefaultToGlobal(ctx, c, subjectName)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Skipping local compatibility check for Schema %q: error reading compatibility level of Subject %q: %s", diff.Id(), subjectName, createDescriptiveError(err, resp)), map[string]interface{}{schemaLoggingKey: diff.Id()})
+		return nil
+	}
+	compatibilityLevel := subjectConfig.GetCompatibilityLevel()
+	oldSchemaReferences, newSchemaReferences := diff.GetChange(paramSchemaReference)
+	issues, err := checkSchemaCompatibilityLocally(diff.Get(paramFormat).(string), compatibilityLevel, oldSchema, newSchema, extractSchemaReferenceNames(oldSchemaReferences), extractSchemaReferenceNames(newSchemaReferences))
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Skipping local compatibility check for Schema %q: %s", diff.Id(), createDescriptiveError(err)), map[string]interface{}{schemaLoggingKey: diff.Id()})
+		return nil
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("error validating Schema: the new schema isn't %s compatible with the previous version of Subject %q:\n- %s", compatibilityLevel, subjectName, strings.Join(issues, "\n- "))
+	}
+	return nil
+}
+
+func extractSchemaReferenceNames(tfSchemaReferences interface{}) []string {
+	var referenceNames []string
+	for _, reference := range buildSchemaReferences(tfSchemaReferences.(*schema.Set).List()) {
+		referenceNames = append(referenceNames, reference.GetName())
+	}
+	return referenceNames
+}
+
 func schemaLookup(ctx context.Context, c *SchemaRegistryRestClient, createSchemaRequest *schemaregistryv1.RegisterSchemaRequest, subjectName string) (*schemaregistryv1.Schema, bool, error) {
 	// https://github.com/confluentinc/terraform-provider-confluent/issues/196#issuecomment-1426437871
 	// Try both normalize=false and normalize=true
@@ -498,6 +610,81 @@ func schemaLookup(ctx context.Context, c *SchemaRegistryRestClient, createSchema
 	return nil, false, nil
 }
 
+// restoreSoftDeletedSchema registers a soft deleted version of the requested schema again with its original version and ID.
+// Schema Registry accepts explicit versions and IDs only in IMPORT mode, so the subject is switched to IMPORT mode
+// for the registration and switched back afterwards. It returns nil when there's no soft deleted version to restore.
+func restoreSoftDeletedSchema(ctx context.Context, c *SchemaRegistryRestClient, createSchemaRequest *schemaregistryv1.RegisterSchemaRequest, subjectName string) (*schemaregistryv1.Schema, error) {
+	softDeletedSchema, resp, err := c.apiClient.SubjectsV1Api.LookUpSchemaUnderSubject(c.apiContext(ctx), subjectName).RegisterSchemaRequest(*createSchemaRequest).Deleted(true).Execute()
+	if ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error looking up soft deleted Schema: %s", createDescriptiveError(err, resp))
+	}
+	// A version that isn't soft deleted is returned by a regular registration
+	_, resp, err = executeSchemaLookup(ctx, c, createSchemaRequest, subjectName, false)
+	if err == nil {
+		return nil, nil
+	}
+	if !ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+		return nil, fmt.Errorf("error looking up Schema: %s", createDescriptiveError(err, resp))
+	}
+
+	subjectMode, resp, err := c.apiClient.ModesV1Api.GetMode(c.apiContext(ctx), subjectName).DefaultToGlobal(false).Execute()
+	hasSubjectMode := err == nil
+	if err != nil && !ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+		return nil, fmt.Errorf("error reading Subject Mode: %s", createDescriptiveError(err, resp))
+	}
+	importModeRequest := schemaregistryv1.NewModeUpdateRequest()
+	importModeRequest.SetMode(modeImport)
+	if _, resp, err := executeSubjectModeUpdate(ctx, c, importModeRequest, subjectName, true); err != nil {
+		return nil, fmt.Errorf("error switching Subject %q to %s mode: %s", subjectName, modeImport, createDescriptiveError(err, resp))
+	}
+	defer func() {
+		var resp *http.Response
+		var err error
+		if hasSubjectMode {
+			previousModeRequest := schemaregistryv1.NewModeUpdateRequest()
+			previousModeRequest.SetMode(subjectMode.GetMode())
+			_, resp, err = executeSubjectModeUpdate(ctx, c, previousModeRequest, subjectName, true)
+		} else {
+			_, resp, err = c.apiClient.ModesV1Api.DeleteSubjectMode(c.apiContext(ctx), subjectName).Execute()
+		}
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error restoring the mode of Subject %q after restoring a soft deleted Schema, the Subject stays in %s mode: %s", subjectName, modeImport, createDescriptiveError(err, resp)))
+		}
+	}()
+
+	restoreSchemaRequest := *createSchemaRequest
+	restoreSchemaRequest.SetVersion(softDeletedSchema.GetVersion())
+	restoreSchemaRequest.SetId(softDeletedSchema.GetId())
+	tflog.Debug(ctx, fmt.Sprintf("Restoring soft deleted version %d of Subject %q with Schema ID %d", softDeletedSchema.GetVersion(), subjectName, softDeletedSchema.GetId()))
+	if _, resp, err := executeSchemaCreate(ctx, c, &restoreSchemaRequest, subjectName); err != nil {
+		return nil, fmt.Errorf("error registering version %d: %s", softDeletedSchema.GetVersion(), createDescriptiveError(err, resp))
+	}
+	return &softDeletedSchema, nil
+}
+
+// schemaRegistryDeletionProtectionCustomizeDiff fails during `terraform plan` when a resource with paramDeletionProtection enabled
+// needs to be recreated. The old value is enforced by the delete function, which can't be reached through the plan otherwise.
+func schemaRegistryDeletionProtectionCustomizeDiff(resourceName string, forceNewAttributes ...string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+		if diff.Id() == "" {
+			return nil
+		}
+		if isDeletionProtected, _ := diff.GetChange(paramDeletionProtection); !isDeletionProtected.(bool) {
+			return nil
+		}
+		for _, attribute := range forceNewAttributes {
+			if diff.HasChange(attribute) {
+				return fmt.Errorf("error updating %s %q: changing %q requires recreating the %s but %s is enabled. "+
+					"Set %s = false and run `terraform apply` before recreating the %s", resourceName, diff.Id(), attribute, strings.ToLower(resourceName), paramDeletionProtection, paramDeletionProtection, strings.ToLower(resourceName))
+			}
+		}
+		return nil
+	}
+}
+
 func schemaLookupByNormalize(ctx context.Context, c *SchemaRegistryRestClient, createSchemaRequest *schemaregistryv1.RegisterSchemaRequest, subjectName string, shouldNormalize bool) (*schemaregistryv1.Schema, bool, error) {
 	srSchema, resp, err := executeSchemaLookup(ctx, c, createSchemaRequest, subjectName, shouldNormalize)
 
@@ -533,7 +720,7 @@ func schemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.Errorf("error creating Schema: %s", createDescriptiveError(err))
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	subjectName := d.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 	format := d.Get(paramFormat).(string)
 	schemaContent := d.Get(paramSchema).(string)
 	schemaReferences := buildSchemaReferences(d.Get(paramSchemaReference).(*schema.Set).List())
@@ -602,12 +789,26 @@ func schemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.Errorf("error creating Schema: error validating a schema: %s", schemaNotCompatibleErrorMessage)
 	}
 
-	tflog.Debug(ctx, fmt.Sprintf("Creating new Schema: %s", createSchemaRequestJson))
+	var registeredSchemaIdentifier int32
+	if d.Get(paramRestoreSoftDeleted).(bool) {
+		restoredSchema, err := restoreSoftDeletedSchema(ctx, schemaRegistryRestClient, createSchemaRequest, subjectName)
+		if err != nil {
+			return diag.Errorf("error creating Schema: error restoring soft deleted Schema: %s", createDescriptiveError(err))
+		}
+		if restoredSchema != nil {
+			registeredSchemaIdentifier = restoredSchema.GetId()
+		}
+	}
 
-	registeredSchema, resp, err := executeSchemaCreate(ctx, schemaRegistryRestClient, createSchemaRequest, subjectName)
+	if registeredSchemaIdentifier == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("Creating new Schema: %s", createSchemaRequestJson))
 
-	if err != nil {
-		return diag.Errorf("error creating Schema: %s", createDescriptiveError(err, resp))
+		registeredSchema, resp, err := executeSchemaCreate(ctx, schemaRegistryRestClient, createSchemaRequest, subjectName)
+
+		if err != nil {
+			return diag.Errorf("error creating Schema: %s", createDescriptiveError(err, resp))
+		}
+		registeredSchemaIdentifier = registeredSchema.GetId()
 	}
 
 	// Save the schema content
@@ -615,7 +816,7 @@ func schemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.FromErr(createDescriptiveError(err))
 	}
 
-	schemaId := createSchemaId(schemaRegistryRestClient.clusterId, subjectName, registeredSchema.GetId(), d.Get(paramRecreateOnUpdate).(bool))
+	schemaId := createSchemaId(schemaRegistryRestClient.clusterId, subjectName, registeredSchemaIdentifier, d.Get(paramRecreateOnUpdate).(bool))
 	d.SetId(schemaId)
 
 	// https://github.com/confluentinc/terraform-provider-confluentcloud/issues/40#issuecomment-1048782379
@@ -623,7 +824,7 @@ func schemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{})
 
 	tflog.Debug(ctx, fmt.Sprintf("Finished creating Schema %q", d.Id()), map[string]interface{}{schemaLoggingKey: d.Id()})
 
-	return schemaRead(ctx, d, meta)
+	return append(schemaRead(ctx, d, meta), unreferencedRuleTagsWarning(d)...)
 }
 
 func schemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -649,9 +850,13 @@ func schemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return diag.Errorf("error %s deleting Schema: %s", deletionType, createDescriptiveError(err))
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	subjectName := d.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 	schemaVersion := d.Get(paramVersion).(int)
 
+	if d.Get(paramDeletionProtection).(bool) {
+		return diag.Errorf("error %s deleting Schema %q: %s is enabled. Set %s = false and run `terraform apply` before deleting or recreating the schema", deletionType, d.Id(), paramDeletionProtection, paramDeletionProtection)
+	}
+
 	// Both soft and hard delete requires a user to run a soft delete first
 	resp, err := executeSchemaDelete(schemaRegistryRestClient.apiContext(ctx), schemaRegistryRestClient, subjectName, strconv.Itoa(schemaVersion), false)
 
@@ -702,6 +907,9 @@ func schemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 	if err != nil {
 		return diag.Errorf("error reading Schema: %s", createDescriptiveError(err))
 	}
+	if err := setSchemaDefaultAttributes(d); err != nil {
+		return diag.Errorf("error reading Schema: %s", createDescriptiveError(err))
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Finished reading Schema %q", d.Id()), map[string]interface{}{schemaLoggingKey: d.Id()})
 
@@ -709,8 +917,8 @@ func schemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) d
 }
 
 func schemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials, paramConfigs, paramHardDelete, paramSchema, paramSchemaReference, paramSkipValidationDuringPlan, paramRecreateOnUpdate, paramRuleset, paramMetadata) {
-		return diag.Errorf("error updating Schema %q: only %q, %q, %q, %q, %q, %q, %q, %q and %q blocks can be updated for Schema", d.Id(), paramCredentials, paramConfigs, paramHardDelete, paramSchema, paramSchemaReference, paramSkipValidationDuringPlan, paramRecreateOnUpdate, paramRuleset, paramMetadata)
+	if d.HasChangesExcept(paramCredentials, paramConfigs, paramHardDelete, paramSchema, paramSchemaReference, paramSkipValidationDuringPlan, paramValidateLocally, paramRecreateOnUpdate, paramRuleset, paramMetadata, paramDeletionProtection, paramRestoreSoftDeleted) {
+		return diag.Errorf("error updating Schema %q: only %q, %q, %q, %q, %q, %q, %q, %q, %q, %q, %q and %q blocks can be updated for Schema", d.Id(), paramCredentials, paramConfigs, paramHardDelete, paramSchema, paramSchemaReference, paramSkipValidationDuringPlan, paramValidateLocally, paramRecreateOnUpdate, paramRuleset, paramMetadata, paramDeletionProtection, paramRestoreSoftDeleted)
 	}
 
 	if d.HasChange(paramRecreateOnUpdate) {
@@ -727,7 +935,7 @@ func schemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 			return diag.Errorf("error updating Schema %q: %s", d.Id(), createDescriptiveError(err))
 		}
 		schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-		subjectName := d.Get(paramSubjectName).(string)
+		subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 		schemaIdentifier := d.Get(paramSchemaIdentifier).(int)
 		recreateOnUpdate := d.Get(paramRecreateOnUpdate).(bool)
 		schemaId := createSchemaId(schemaRegistryRestClient.clusterId, subjectName, int32(schemaIdentifier), recreateOnUpdate)
@@ -762,7 +970,7 @@ func schemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{})
 		return schemaCreate(ctx, d, meta)
 	}
 
-	return schemaRead(ctx, d, meta)
+	return append(schemaRead(ctx, d, meta), unreferencedRuleTagsWarning(d)...)
 }
 
 func createSchemaId(clusterId, subjectName string, identifier int32, shouldRecreateOnUpdate bool) string {
@@ -805,6 +1013,9 @@ func extractSubjectInfoFromTfId(terraformId string) (string, string, string, err
 	clusterId := parts[0]
 	identifier := parts[length-1]
 	name := strings.Join(parts[1:length-1], "/")
+	if err := validateQualifiedSubjectName(name); err != nil {
+		return "", "", "", err
+	}
 
 	return clusterId, name, identifier, nil
 }
@@ -836,6 +1047,9 @@ func schemaImport(ctx context.Context, d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return nil, fmt.Errorf("error importing Schema %q: %s", d.Id(), createDescriptiveError(err))
 	}
+	if err := setSchemaDefaultAttributes(d); err != nil {
+		return nil, fmt.Errorf("error importing Schema %q: %s", d.Id(), createDescriptiveError(err))
+	}
 	tflog.Debug(ctx, fmt.Sprintf("Finished importing Schema %q", d.Id()), map[string]interface{}{schemaLoggingKey: d.Id()})
 	return []*schema.ResourceData{d}, nil
 }
@@ -899,7 +1113,7 @@ func readSchemaRegistryConfigAndSetAttributes(ctx context.Context, d *schema.Res
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Fetched Schema %q: %s", d.Id(), schemaJson), map[string]interface{}{schemaLoggingKey: d.Id()})
 
-	if err := d.Set(paramSubjectName, srSchema.GetSubject()); err != nil {
+	if err := setSubjectNameAndContext(d, srSchema.GetSubject()); err != nil {
 		return nil, err
 	}
 	// The schema format: AVRO is the default (if no schema type is shown on the response, the type is AVRO), PROTOBUF, JSONSCHEMA
@@ -977,6 +1191,27 @@ func readSchemaRegistryConfigAndSetAttributes(ctx context.Context, d *schema.Res
 	return srSchema, nil
 }
 
+// setSchemaDefaultAttributes sets the attributes that only the resource has, which is why
+// they aren't set by readSchemaRegistryConfigAndSetAttributes that the data source shares.
+func setSchemaDefaultAttributes(d *schema.ResourceData) error {
+	if d.Id() == "" {
+		return nil
+	}
+	// Explicitly set paramDeletionProtection to the default value if unset
+	if _, ok := d.GetOk(paramDeletionProtection); !ok {
+		if err := d.Set(paramDeletionProtection, paramDeletionProtectionDefaultValue); err != nil {
+			return err
+		}
+	}
+	// Explicitly set paramRestoreSoftDeleted to the default value if unset
+	if _, ok := d.GetOk(paramRestoreSoftDeleted); !ok {
+		if err := d.Set(paramRestoreSoftDeleted, paramRestoreSoftDeletedDefaultValue); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func findSchemaById(schemas []schemaregistryv1.Schema, schemaIdentifier string, subjectName string) (schemaregistryv1.Schema, bool) {
 	// 'schema' collides with a package name
 	for _, srSchema := range schemas {
@@ -991,6 +1226,10 @@ func executeSchemaValidate(ctx context.Context, c *SchemaRegistryRestClient, req
 	return c.apiClient.CompatibilityV1Api.TestCompatibilityForSubject(c.apiContext(ctx), subjectName).RegisterSchemaRequest(*requestData).Verbose(true).Execute()
 }
 
+func executeSubjectConfigReadWithDefaultToGlobal(ctx context.Context, c *SchemaRegistryRestClient, subjectName string) (schemaregistryv1.Config, *http.Response, error) {
+	return c.apiClient.ConfigV1Api.GetSubjectLevelConfig(c.apiContext(ctx), subjectName).DefaultToGlobal(true).Execute()
+}
+
 func executeSchemaLookup(ctx context.Context, c *SchemaRegistryRestClient, requestData *schemaregistryv1.RegisterSchemaRequest, subjectName string, shouldNormalize bool) (schemaregistryv1.Schema, *http.Response, error) {
 	return c.apiClient.SubjectsV1Api.LookUpSchemaUnderSubject(c.apiContext(ctx), subjectName).RegisterSchemaRequest(*requestData).Normalize(shouldNormalize).Execute()
 }
@@ -1084,7 +1323,21 @@ func extractSchemaRegistryRestEndpoint(client *Client, d *schema.ResourceData, i
 	if restEndpoint != "" {
 		return restEndpoint, nil
 	}
-	return "", fmt.Errorf("one of provider.schema_registry_rest_endpoint (defaults to SCHEMA_REGISTRY_REST_ENDPOINT environment variable) or resource.rest_endpoint must be set")
+	if cluster, ok := extractSchemaRegistryClusterMetadataFromProviderBlock(client, d); ok && cluster.restEndpoint != "" {
+		return cluster.restEndpoint, nil
+	}
+	return "", fmt.Errorf("one of provider.schema_registry_rest_endpoint (defaults to SCHEMA_REGISTRY_REST_ENDPOINT environment variable), provider.schema_registry_clusters.rest_endpoint or resource.rest_endpoint must be set")
+}
+
+// extractSchemaRegistryClusterMetadataFromProviderBlock returns the entry of the schema_registry_clusters blocks
+// of the provider block for the Schema Registry cluster of the resource, if any.
+func extractSchemaRegistryClusterMetadataFromProviderBlock(client *Client, d *schema.ResourceData) (providerClusterMetadata, bool) {
+	clusterId, err := extractSchemaRegistryClusterId(client, d, false)
+	if err != nil {
+		return providerClusterMetadata{}, false
+	}
+	cluster, ok := client.schemaRegistryRestClientFactory.clusters[clusterId]
+	return cluster, ok
 }
 
 func extractSchemaRegistryClusterApiKeyAndApiSecret(client *Client, d *schema.ResourceData, isImportOperation bool) (string, string, error) {
@@ -1107,7 +1360,10 @@ func extractSchemaRegistryClusterApiKeyAndApiSecret(client *Client, d *schema.Re
 	if clusterApiKey != "" {
 		return clusterApiKey, clusterApiSecret, nil
 	}
-	return "", "", fmt.Errorf("one of (provider.schema_registry_api_key, provider.schema_registry_api_secret), (SCHEMA_REGISTRY_API_KEY, SCHEMA_REGISTRY_API_SECRET environment variables) or (resource.credentials.key, resource.credentials.secret) must be set")
+	if cluster, ok := extractSchemaRegistryClusterMetadataFromProviderBlock(client, d); ok && cluster.apiKey != "" {
+		return cluster.apiKey, cluster.apiSecret, nil
+	}
+	return "", "", fmt.Errorf("one of (provider.schema_registry_api_key, provider.schema_registry_api_secret), (SCHEMA_REGISTRY_API_KEY, SCHEMA_REGISTRY_API_SECRET environment variables), (provider.schema_registry_clusters.api_key, provider.schema_registry_clusters.api_secret) or (resource.credentials.key, resource.credentials.secret) must be set")
 }
 
 func extractSchemaRegistryClusterId(client *Client, d *schema.ResourceData, isImportOperation bool) (string, error) {
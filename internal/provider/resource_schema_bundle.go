@@ -0,0 +1,411 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+
+	schemaregistryv1 "github.com/confluentinc/ccloud-sdk-go-v2/schema-registry/v1"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func schemaBundleResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: schemaBundleCreate,
+		ReadContext:   schemaBundleRead,
+		UpdateContext: schemaBundleUpdate,
+		DeleteContext: schemaBundleDelete,
+		Schema: map[string]*schema.Schema{
+			// ID = lsrc-123/path
+			paramSchemaRegistryCluster: schemaRegistryClusterBlockSchema(),
+			paramRestEndpoint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The REST endpoint of the Schema Registry cluster, for example, `https://psrc-00000.us-central1.gcp.confluent.cloud:443`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+			},
+			paramCredentials: credentialsSchema(),
+			paramPath: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "A directory (read recursively) or a glob pattern that matches the .avsc, .json and .proto files of the bundle.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			paramSubjectNameStrategy: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      recordNameStrategy,
+				Description:  "The strategy that derives the subject of each file of the bundle.",
+				ValidateFunc: validation.StringInSlice(acceptedSchemaBundleSubjectNameStrategies, false),
+			},
+			paramHardDelete: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     paramHardDeleteDefaultValue,
+				Description: "Controls whether the schemas of the bundle should be soft or hard deleted. Set it to `true` if you want to hard delete the schemas on destroy. Defaults to `false` (soft delete).",
+			},
+			paramFileHashes: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The SHA-256 hashes of the registered files of the bundle, keyed by their paths.",
+			},
+			paramSchemas: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The schemas of the bundle in the order they were registered in.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramFile: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The path of the file, relative to the bundle's directory.",
+						},
+						paramSubjectName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the Schema Registry Subject.",
+						},
+						paramFormat: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The format of the Schema.",
+						},
+						paramVersion: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The version number of the Schema.",
+						},
+						paramSchemaIdentifier: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Globally unique identifier of the Schema.",
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: customdiff.Sequence(setSchemaBundleDiff),
+	}
+}
+
+// setSchemaBundleDiff loads the bundle during terraform plan, so that missing references and cycles are reported
+// before anything is registered, and plans a new registration whenever any file of the bundle has changed.
+func setSchemaBundleDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown(paramPath) {
+		if err := diff.SetNewComputed(paramFileHashes); err != nil {
+			return fmt.Errorf("error customizing diff Schema Bundle: %s", createDescriptiveError(err))
+		}
+		return diff.SetNewComputed(paramSchemas)
+	}
+	files, err := loadSchemaBundle(diff.Get(paramPath).(string))
+	if err != nil {
+		return fmt.Errorf("error loading Schema Bundle: %s", createDescriptiveError(err))
+	}
+	fileHashes := make(map[string]interface{})
+	for _, file := range files {
+		if _, err := schemaBundleSubjectName(diff.Get(paramSubjectNameStrategy).(string), file); err != nil {
+			return fmt.Errorf("error loading Schema Bundle: %s", createDescriptiveError(err))
+		}
+		fileHashes[file.path] = file.hash
+	}
+	if reflect.DeepEqual(diff.Get(paramFileHashes).(map[string]interface{}), fileHashes) {
+		return nil
+	}
+	if err := diff.SetNew(paramFileHashes, fileHashes); err != nil {
+		return fmt.Errorf("error customizing diff Schema Bundle: %s", createDescriptiveError(err))
+	}
+	return diff.SetNewComputed(paramSchemas)
+}
+
+func schemaBundleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error creating Schema Bundle: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error creating Schema Bundle: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error creating Schema Bundle: %s", createDescriptiveError(err))
+	}
+	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
+
+	schemaBundleId := createSchemaBundleId(clusterId, d.Get(paramPath).(string))
+	if err := registerSchemaBundle(ctx, d, schemaRegistryRestClient); err != nil {
+		registeredCount := len(d.Get(paramSchemas).([]interface{}))
+		if registeredCount == 0 {
+			return diag.Errorf("error creating Schema Bundle %q: %s", schemaBundleId, createDescriptiveError(err))
+		}
+		// Keep track of the schemas that have been registered instead of tainting the resource,
+		// so that the next plan registers the remaining files
+		d.SetId(schemaBundleId)
+		tflog.Warn(ctx, fmt.Sprintf("Registered only %d files of Schema Bundle %q: %s", registeredCount, d.Id(), createDescriptiveError(err)), map[string]interface{}{schemaBundleLoggingKey: d.Id()})
+		diags := schemaBundleRead(ctx, d, meta)
+		return append(diags, schemaBundlePartiallyRegisteredWarning(registeredCount, err))
+	}
+	d.SetId(schemaBundleId)
+
+	SleepIfNotTestMode(schemaRegistryAPIWaitAfterCreateOrDelete, meta.(*Client).isAcceptanceTestMode, meta.(*Client).isLiveProductionTestMode)
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Schema Bundle %q", d.Id()), map[string]interface{}{schemaBundleLoggingKey: d.Id()})
+
+	return schemaBundleRead(ctx, d, meta)
+}
+
+func schemaBundlePartiallyRegisteredWarning(registeredCount int, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Schema Bundle was registered partially",
+		Detail:   fmt.Sprintf("Only %d files of the bundle were registered: %s\n\nThe registered schemas are saved to the Terraform state. Re-run 'terraform apply' to register the remaining files.", registeredCount, createDescriptiveError(err)),
+	}
+}
+
+// registerSchemaBundle registers the files of the bundle in dependency order, referencing the versions registered
+// for the files they depend on, and stores the registered versions as it goes.
+func registerSchemaBundle(ctx context.Context, d *schema.ResourceData, c *SchemaRegistryRestClient) error {
+	files, err := loadSchemaBundle(d.Get(paramPath).(string))
+	if err != nil {
+		return err
+	}
+	subjectNameStrategy := d.Get(paramSubjectNameStrategy).(string)
+
+	tfSchemas := make([]map[string]interface{}, 0, len(files))
+	fileHashes := make(map[string]interface{})
+	registeredSchemas := make(map[string]map[string]interface{})
+	saveRegisteredSchemas := func() error {
+		if err := d.Set(paramSchemas, tfSchemas); err != nil {
+			return err
+		}
+		return d.Set(paramFileHashes, fileHashes)
+	}
+
+	for _, file := range files {
+		subjectName, err := schemaBundleSubjectName(subjectNameStrategy, file)
+		if err != nil {
+			return err
+		}
+		tfReferences := make([]interface{}, len(file.references))
+		for i, reference := range file.references {
+			tfReferences[i] = map[string]interface{}{
+				paramName:        reference.name,
+				paramSubjectName: registeredSchemas[reference.path][paramSubjectName],
+				paramVersion:     registeredSchemas[reference.path][paramVersion],
+			}
+		}
+		createSchemaRequest := schemaregistryv1.NewRegisterSchemaRequest()
+		createSchemaRequest.SetSchemaType(file.format)
+		createSchemaRequest.SetSchema(file.content)
+		createSchemaRequest.SetReferences(buildSchemaReferences(tfReferences))
+
+		tflog.Debug(ctx, fmt.Sprintf("Registering %q of Schema Bundle %q under Subject %q", file.path, d.Id(), subjectName), map[string]interface{}{schemaBundleLoggingKey: d.Id()})
+		registeredSchema, resp, err := executeSchemaCreate(ctx, c, createSchemaRequest, subjectName)
+		if err != nil {
+			_ = saveRegisteredSchemas()
+			return fmt.Errorf("error registering %q under Subject %q: %s", file.path, subjectName, createDescriptiveError(err, resp))
+		}
+		// Registering returns the schema identifier only, so the version is looked up
+		lookedUpSchema, resp, err := executeSchemaLookup(ctx, c, createSchemaRequest, subjectName, false)
+		if err != nil {
+			_ = saveRegisteredSchemas()
+			return fmt.Errorf("error looking up %q under Subject %q: %s", file.path, subjectName, createDescriptiveError(err, resp))
+		}
+
+		tfSchema := map[string]interface{}{
+			paramFile:             file.path,
+			paramSubjectName:      subjectName,
+			paramFormat:           file.format,
+			paramVersion:          int(lookedUpSchema.GetVersion()),
+			paramSchemaIdentifier: int(registeredSchema.GetId()),
+		}
+		registeredSchemas[file.path] = tfSchema
+		tfSchemas = append(tfSchemas, tfSchema)
+		fileHashes[file.path] = file.hash
+	}
+	return saveRegisteredSchemas()
+}
+
+func schemaBundleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Schema Bundle %q", d.Id()), map[string]interface{}{schemaBundleLoggingKey: d.Id()})
+
+	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Schema Bundle: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Schema Bundle: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error reading Schema Bundle: %s", createDescriptiveError(err))
+	}
+	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
+
+	tfSchemas := make([]map[string]interface{}, 0)
+	fileHashes := d.Get(paramFileHashes).(map[string]interface{})
+	for _, tfSchema := range d.Get(paramSchemas).([]interface{}) {
+		tfSchemaMap := tfSchema.(map[string]interface{})
+		subjectName := tfSchemaMap[paramSubjectName].(string)
+		version := tfSchemaMap[paramVersion].(int)
+		srSchema, resp, err := schemaRegistryRestClient.apiClient.SubjectsV1Api.GetSchemaByVersion(schemaRegistryRestClient.apiContext(ctx), subjectName, strconv.Itoa(version)).Execute()
+		if err != nil {
+			if isNonKafkaRestApiResourceNotFound(resp) {
+				// The file is registered again on the next apply
+				tflog.Warn(ctx, fmt.Sprintf("Version %d of Subject %q of Schema Bundle %q is not found", version, subjectName, d.Id()), map[string]interface{}{schemaBundleLoggingKey: d.Id()})
+				delete(fileHashes, tfSchemaMap[paramFile].(string))
+				continue
+			}
+			return diag.Errorf("error reading Schema Bundle %q: error reading version %d of Subject %q: %s", d.Id(), version, subjectName, createDescriptiveError(err, resp))
+		}
+		tfSchemaMap[paramSchemaIdentifier] = int(srSchema.GetId())
+		tfSchemas = append(tfSchemas, tfSchemaMap)
+	}
+	if err := d.Set(paramSchemas, tfSchemas); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+	if err := d.Set(paramFileHashes, fileHashes); err != nil {
+		return diag.FromErr(createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Schema Bundle %q", d.Id()), map[string]interface{}{schemaBundleLoggingKey: d.Id()})
+
+	return nil
+}
+
+func schemaBundleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramCredentials, paramPath, paramHardDelete, paramFileHashes, paramSchemas) {
+		return diag.Errorf("error updating Schema Bundle %q: only %q, %q and %q attributes can be updated for Schema Bundle", d.Id(), paramCredentials, paramPath, paramHardDelete)
+	}
+	if !d.HasChanges(paramPath, paramFileHashes) {
+		return schemaBundleRead(ctx, d, meta)
+	}
+
+	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error updating Schema Bundle: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error updating Schema Bundle: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error updating Schema Bundle: %s", createDescriptiveError(err))
+	}
+	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
+
+	oldTfSchemas, _ := d.GetChange(paramSchemas)
+	// Files whose content hasn't changed are registered again too, which Schema Registry treats as a no-op
+	if err := registerSchemaBundle(ctx, d, schemaRegistryRestClient); err != nil {
+		return diag.Errorf("error updating Schema Bundle %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	// Delete the subjects that are no longer part of the bundle
+	subjectNames := make(map[string]bool)
+	for _, tfSchema := range d.Get(paramSchemas).([]interface{}) {
+		subjectNames[tfSchema.(map[string]interface{})[paramSubjectName].(string)] = true
+	}
+	var removedTfSchemas []interface{}
+	for _, tfSchema := range oldTfSchemas.([]interface{}) {
+		if !subjectNames[tfSchema.(map[string]interface{})[paramSubjectName].(string)] {
+			removedTfSchemas = append(removedTfSchemas, tfSchema)
+		}
+	}
+	if err := deleteSchemaBundleSubjects(ctx, schemaRegistryRestClient, removedTfSchemas, d.Get(paramHardDelete).(bool)); err != nil {
+		return diag.Errorf("error updating Schema Bundle %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	// The ID includes the path, so moving the files of the bundle updates the ID instead of registering the bundle from scratch
+	if d.HasChange(paramPath) {
+		d.SetId(createSchemaBundleId(clusterId, d.Get(paramPath).(string)))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished updating Schema Bundle %q", d.Id()), map[string]interface{}{schemaBundleLoggingKey: d.Id()})
+
+	return schemaBundleRead(ctx, d, meta)
+}
+
+func schemaBundleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Schema Bundle %q", d.Id()), map[string]interface{}{schemaBundleLoggingKey: d.Id()})
+
+	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Schema Bundle: %s", createDescriptiveError(err))
+	}
+	clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Schema Bundle: %s", createDescriptiveError(err))
+	}
+	clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return diag.Errorf("error deleting Schema Bundle: %s", createDescriptiveError(err))
+	}
+	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
+
+	if err := deleteSchemaBundleSubjects(ctx, schemaRegistryRestClient, d.Get(paramSchemas).([]interface{}), d.Get(paramHardDelete).(bool)); err != nil {
+		return diag.Errorf("error deleting Schema Bundle %q: %s", d.Id(), createDescriptiveError(err))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Schema Bundle %q", d.Id()), map[string]interface{}{schemaBundleLoggingKey: d.Id()})
+
+	return nil
+}
+
+// deleteSchemaBundleSubjects deletes the subjects of the given schemas in the reverse order of their registration,
+// since Schema Registry doesn't delete schemas that are still referenced. Deleting the subjects deletes every version
+// the bundle has registered, not only the latest one.
+func deleteSchemaBundleSubjects(ctx context.Context, c *SchemaRegistryRestClient, tfSchemas []interface{}, isHardDeleteEnabled bool) error {
+	deletedSubjectNames := make(map[string]bool)
+	for i := len(tfSchemas) - 1; i >= 0; i-- {
+		subjectName := tfSchemas[i].(map[string]interface{})[paramSubjectName].(string)
+		if deletedSubjectNames[subjectName] {
+			continue
+		}
+		// Both soft and hard delete requires a user to run a soft delete first
+		if resp, err := executeSchemaBundleSubjectDelete(ctx, c, subjectName, false); err != nil && !isNonKafkaRestApiResourceNotFound(resp) {
+			return fmt.Errorf("error soft deleting Subject %q: %s", subjectName, createDescriptiveError(err, resp))
+		}
+		if isHardDeleteEnabled {
+			if resp, err := executeSchemaBundleSubjectDelete(ctx, c, subjectName, true); err != nil && !isNonKafkaRestApiResourceNotFound(resp) {
+				return fmt.Errorf("error hard deleting Subject %q: %s", subjectName, createDescriptiveError(err, resp))
+			}
+		}
+		deletedSubjectNames[subjectName] = true
+	}
+	return nil
+}
+
+func executeSchemaBundleSubjectDelete(ctx context.Context, c *SchemaRegistryRestClient, subjectName string, isHardDelete bool) (*http.Response, error) {
+	_, resp, err := c.apiClient.SubjectsV1Api.DeleteSubject(c.apiContext(ctx), subjectName).Permanent(isHardDelete).Execute()
+	return resp, err
+}
+
+func createSchemaBundleId(clusterId, path string) string {
+	return fmt.Sprintf("%s/%s", clusterId, path)
+}
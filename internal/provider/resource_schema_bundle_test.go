@@ -0,0 +1,138 @@
+// Copyright 2026 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+const schemaBundleResourceLabel = "confluent_schema_bundle.main"
+
+func TestAccSchemaBundle(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	bundleDirectory := writeSchemaBundleFiles(t, map[string]string{
+		"address.avsc": `{"type": "record", "name": "Address", "namespace": "io.confluent", "fields": [{"name": "street", "type": "string"}]}`,
+		"user.avsc":    `{"type": "record", "name": "User", "namespace": "io.confluent", "fields": [{"name": "address", "type": "Address"}]}`,
+		"order.avsc":   `{"type": "record", "name": "Order", "namespace": "io.confluent", "fields": [{"name": "id", "type": "string"}]}`,
+	})
+
+	registerStubs := make(map[string]*wiremock.StubRule)
+	deleteStubs := make(map[string]*wiremock.StubRule)
+	for subjectName, schemaIdentifier := range map[string]int{"io.confluent.Address": 100001, "io.confluent.User": 100002, "io.confluent.Order": 100003} {
+		subjectPath := fmt.Sprintf("/subjects/%s", subjectName)
+		registeredSchema := fmt.Sprintf(`{"subject": "%s", "version": 1, "id": %d, "schema": "{}"}`, subjectName, schemaIdentifier)
+
+		registerStubs[subjectName] = wiremock.Post(wiremock.URLPathEqualTo(subjectPath+"/versions")).
+			WillReturn(fmt.Sprintf(`{"id": %d}`, schemaIdentifier), contentTypeJSONHeader, http.StatusOK)
+		_ = wiremockClient.StubFor(registerStubs[subjectName])
+		// Registering returns the schema identifier only, so the version is looked up
+		_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(subjectPath)).
+			WillReturn(registeredSchema, contentTypeJSONHeader, http.StatusOK))
+		_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(subjectPath+"/versions/1")).
+			WillReturn(registeredSchema, contentTypeJSONHeader, http.StatusOK))
+		deleteStubs[subjectName] = wiremock.Delete(wiremock.URLPathEqualTo(subjectPath)).
+			WillReturn("[1]", contentTypeJSONHeader, http.StatusOK)
+		_ = wiremockClient.StubFor(deleteStubs[subjectName])
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSchemaBundleConfig(mockServerUrl, bundleDirectory),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(schemaBundleResourceLabel, "id", fmt.Sprintf("%s/%s", testStreamGovernanceClusterId, bundleDirectory)),
+					resource.TestCheckResourceAttr(schemaBundleResourceLabel, "schemas.#", "3"),
+					resource.TestCheckResourceAttr(schemaBundleResourceLabel, "file_hashes.%", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(schemaBundleResourceLabel, "schemas.*", map[string]string{
+						"file":              "order.avsc",
+						"subject_name":      "io.confluent.Order",
+						"format":            avroFormat,
+						"version":           "1",
+						"schema_identifier": "100003",
+					}),
+				),
+			},
+			{
+				// Dropping a file deletes its subject, while the remaining files are registered again
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(bundleDirectory, "order.avsc")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccCheckSchemaBundleConfig(mockServerUrl, bundleDirectory),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(schemaBundleResourceLabel, "schemas.#", "2"),
+					resource.TestCheckResourceAttr(schemaBundleResourceLabel, "file_hashes.%", "2"),
+					resource.TestCheckNoResourceAttr(schemaBundleResourceLabel, "file_hashes.order.avsc"),
+					// The referenced file is registered first
+					resource.TestCheckResourceAttr(schemaBundleResourceLabel, "schemas.0.file", "address.avsc"),
+					resource.TestCheckResourceAttr(schemaBundleResourceLabel, "schemas.0.subject_name", "io.confluent.Address"),
+					resource.TestCheckResourceAttr(schemaBundleResourceLabel, "schemas.1.file", "user.avsc"),
+					resource.TestCheckResourceAttr(schemaBundleResourceLabel, "schemas.1.subject_name", "io.confluent.User"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, registerStubs["io.confluent.Address"], "POST /subjects/io.confluent.Address/versions", expectedCountTwo)
+	checkStubCount(t, wiremockClient, registerStubs["io.confluent.User"], "POST /subjects/io.confluent.User/versions", expectedCountTwo)
+	checkStubCount(t, wiremockClient, registerStubs["io.confluent.Order"], "POST /subjects/io.confluent.Order/versions", expectedCountOne)
+	// Every subject is deleted once: io.confluent.Order on the update, and the remaining ones on destroy
+	checkStubCount(t, wiremockClient, deleteStubs["io.confluent.Order"], "DELETE /subjects/io.confluent.Order", expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteStubs["io.confluent.User"], "DELETE /subjects/io.confluent.User", expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteStubs["io.confluent.Address"], "DELETE /subjects/io.confluent.Address", expectedCountOne)
+}
+
+func testAccCheckSchemaBundleConfig(mockServerUrl, bundleDirectory string) string {
+	return fmt.Sprintf(`
+	resource "confluent_schema_bundle" "main" {
+	  schema_registry_cluster {
+	    id = "%s"
+	  }
+	  rest_endpoint = "%s"
+	  credentials {
+	    key    = "%s"
+	    secret = "%s"
+	  }
+
+	  path = "%s"
+	}
+	`, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret, bundleDirectory)
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+func TestAccSchemaDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo("/compatibility"+ordersSchemaSubjectPath+"/versions")).
+		WillReturn(`{"is_compatible": true}`, contentTypeJSONHeader, http.StatusOK))
+	registerSchemaStub := wiremock.Post(wiremock.URLPathEqualTo(ordersSchemaSubjectPath+"/versions")).
+		WillReturn(`{"id": 100007}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(registerSchemaStub)
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/schemas")).
+		WithQueryParam("subjectPrefix", wiremock.EqualTo("orders-value")).
+		WillReturn(ordersSchemasResponse, contentTypeJSONHeader, http.StatusOK))
+	deleteSchemaStub := wiremock.Delete(wiremock.URLPathEqualTo(ordersSchemaSubjectPath+"/versions/3")).
+		WillReturn("3", contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(deleteSchemaStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckOrdersSchemaConfig(mockServerUrl, "orders-value", true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ordersSchemaResourceLabel, "id", fmt.Sprintf("%s/orders-value/100007", testStreamGovernanceClusterId)),
+					resource.TestCheckResourceAttr(ordersSchemaResourceLabel, "deletion_protection", "true"),
+				),
+			},
+			{
+				// Replacing the schema fails during terraform plan
+				Config:      testAccCheckOrdersSchemaConfig(mockServerUrl, "payments-value", true, false),
+				ExpectError: regexp.MustCompile(`changing "subject_name" requires recreating the schema but deletion_protection is enabled`),
+			},
+			{
+				Config:      testAccCheckOrdersSchemaConfig(mockServerUrl, "orders-value", true, false),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("deletion_protection is enabled"),
+			},
+			{
+				// Disabling deletion_protection is an in-place update, after which the schema can be destroyed
+				Config: testAccCheckOrdersSchemaConfig(mockServerUrl, "orders-value", false, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ordersSchemaResourceLabel, "id", fmt.Sprintf("%s/orders-value/100007", testStreamGovernanceClusterId)),
+					resource.TestCheckResourceAttr(ordersSchemaResourceLabel, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, registerSchemaStub, fmt.Sprintf("POST %s/versions", ordersSchemaSubjectPath), expectedCountOne)
+	// The schema is only deleted once deletion_protection is disabled
+	checkStubCount(t, wiremockClient, deleteSchemaStub, fmt.Sprintf("DELETE %s/versions/3", ordersSchemaSubjectPath), expectedCountOne)
+}
@@ -156,6 +156,9 @@ func TestAccLatestSchema(t *testing.T) {
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "credentials.0.key", testSchemaRegistryKey),
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "credentials.0.secret", testSchemaRegistrySecret),
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "subject_name", testSubjectName),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "context", ""),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "restore_soft_deleted", "false"),
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "format", testFormat),
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "schema", testSchemaContent),
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "version", strconv.Itoa(testSchemaVersion)),
@@ -252,6 +255,9 @@ func TestAccLatestSchema(t *testing.T) {
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "credentials.0.key", testSchemaRegistryUpdatedKey),
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "credentials.0.secret", testSchemaRegistryUpdatedSecret),
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "subject_name", testSubjectName),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "context", ""),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "deletion_protection", "false"),
+					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "restore_soft_deleted", "false"),
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "format", testFormat),
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "schema", testSchemaContent),
 					resource.TestCheckResourceAttr(fullSchemaResourceLabel, "version", strconv.Itoa(testSchemaVersion)),
@@ -22,6 +22,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -34,6 +35,8 @@ import (
 
 var acceptedDekAlgorithm = []string{"AES128_GCM", "AES256_GCM", "AES256_SIV"}
 
+const defaultDekVersion = 1
+
 func schemaRegistryDekResource() *schema.Resource {
 	return &schema.Resource{
 		ReadContext:   schemaRegistryDekRead,
@@ -66,9 +69,10 @@ func schemaRegistryDekResource() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			paramVersion: {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  1,
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The version of the DEK. Defaults to `1`. When the DEK is rotated, it's the latest version.",
 			},
 			paramAlgorithm: {
 				Type:         schema.TypeString,
@@ -91,8 +95,26 @@ func schemaRegistryDekResource() *schema.Resource {
 				Default:     paramHardDeleteDefaultValue,
 				Description: "Controls whether a dek should be soft or hard deleted. Set it to `true` if you want to hard delete a schema registry dek on destroy. Defaults to `false` (soft delete).",
 			},
+			paramRotationPeriod: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "The period after which a new version of the DEK is created, for example, `720h`.",
+				ValidateFunc:  validateDekRotationPeriod,
+				ConflictsWith: []string{paramVersion, paramEncryptedKeyMaterial},
+			},
+			paramRotateTrigger: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Description:   "An arbitrary value that creates a new version of the DEK whenever it changes.",
+				ConflictsWith: []string{paramVersion, paramEncryptedKeyMaterial},
+			},
+			paramCreatedAt: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the version of the DEK was created, in RFC3339 format.",
+			},
 		},
-		CustomizeDiff: customdiff.Sequence(resourceCredentialBlockValidationWithOAuth),
+		CustomizeDiff: customdiff.Sequence(resourceCredentialBlockValidationWithOAuth, schemaRegistryDekRotationCustomizeDiff),
 	}
 }
 
@@ -113,8 +135,15 @@ func schemaRegistryDekCreate(ctx context.Context, d *schema.ResourceData, meta i
 	kekName := d.Get(paramKekName).(string)
 	subject := d.Get(paramSubjectName).(string)
 	version := d.Get(paramVersion).(int)
+	if version == 0 {
+		version = defaultDekVersion
+	}
 	algorithm := d.Get(paramAlgorithm).(string)
 	dekId := createDekId(clusterId, kekName, subject, algorithm, int32(version))
+	// A rotated DEK gets new versions over time, so its ID doesn't change when it's rotated
+	if isDekRotationConfigured(d.Get(paramRotationPeriod).(string), d.Get(paramRotateTrigger).(string)) {
+		dekId = createRotatedDekId(clusterId, kekName, subject, algorithm)
+	}
 
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
 	dekRequest := schemaregistryv1.CreateDekRequest{}
@@ -178,14 +207,19 @@ func readSchemaRegistryDekAndSetAttributes(ctx context.Context, d *schema.Resour
 	subject := d.Get(paramSubjectName).(string)
 	version := d.Get(paramVersion).(int)
 	algorithm := d.Get(paramAlgorithm).(string)
-	dekId := createDekId(clusterId, kekName, subject, algorithm, int32(version))
+	dekId := d.Id()
 
 	tflog.Debug(ctx, fmt.Sprintf("Reading Schema Registry DEK %q=%q", paramId, dekId), map[string]interface{}{schemaRegistryDekKey: dekId})
 
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	request := schemaRegistryRestClient.apiClient.DataEncryptionKeysV1Api.GetDekByVersion(schemaRegistryRestClient.apiContext(ctx), kekName, subject, strconv.Itoa(version))
-	request = request.Algorithm(algorithm)
-	dek, resp, err := request.Execute()
+	var dek schemaregistryv1.Dek
+	var resp *http.Response
+	if version == 0 {
+		// A rotated DEK that is imported by an ID without a version starts with its latest version
+		dek, resp, err = schemaRegistryRestClient.apiClient.DataEncryptionKeysV1Api.GetDek(schemaRegistryRestClient.apiContext(ctx), kekName, subject).Algorithm(algorithm).Execute()
+	} else {
+		dek, resp, err = schemaRegistryRestClient.apiClient.DataEncryptionKeysV1Api.GetDekByVersion(schemaRegistryRestClient.apiContext(ctx), kekName, subject, strconv.Itoa(version)).Algorithm(algorithm).Execute()
+	}
 	if err != nil {
 		tflog.Warn(ctx, fmt.Sprintf("Error reading Schema Registry DEK %q: %s", dekId, createDescriptiveError(err, resp)), map[string]interface{}{schemaRegistryDekKey: dekId})
 
@@ -214,13 +248,139 @@ func readSchemaRegistryDekAndSetAttributes(ctx context.Context, d *schema.Resour
 }
 
 func schemaRegistryDekUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials, paramHardDelete) {
-		return diag.Errorf("error updating Schema Registry KEK %q: only %q, %q attributes can be updated for Schema Registry KEK", d.Id(), paramCredentials, paramHardDelete)
+	// A new version is planned by schemaRegistryDekRotationCustomizeDiff, which is the only way the version changes when rotation is configured
+	isRotationPlanned := d.HasChange(paramVersion) && isDekRotationConfigured(d.Get(paramRotationPeriod).(string), d.Get(paramRotateTrigger).(string))
+	if isRotationPlanned {
+		if d.HasChangesExcept(paramCredentials, paramHardDelete, paramRotationPeriod, paramRotateTrigger, paramVersion, paramEncryptedKeyMaterial, paramKeyMaterial, paramCreatedAt) {
+			return diag.Errorf("error updating Schema Registry DEK %q: only %q, %q, %q, %q attributes can be updated for Schema Registry DEK", d.Id(), paramCredentials, paramHardDelete, paramRotationPeriod, paramRotateTrigger)
+		}
+		oldRotateTrigger, newRotateTrigger := d.GetChange(paramRotateTrigger)
+		oldVersion, _ := d.GetChange(paramVersion)
+		createdAt, _ := d.GetChange(paramCreatedAt)
+		isRotationRequired, err := isDekRotationRequired(oldRotateTrigger.(string), newRotateTrigger.(string), d.Get(paramRotationPeriod).(string), createdAt.(string), time.Now())
+		if err != nil {
+			return diag.Errorf("error updating Schema Registry DEK %q: %s", d.Id(), createDescriptiveError(err))
+		}
+		if isRotationRequired {
+			if err := rotateSchemaRegistryDek(ctx, d, meta); err != nil {
+				return diag.Errorf("error rotating Schema Registry DEK %q: %s", d.Id(), createDescriptiveError(err))
+			}
+		} else if err := d.Set(paramVersion, oldVersion); err != nil {
+			// A rotation that was planned because rotate_trigger was unknown isn't needed after all
+			return diag.FromErr(createDescriptiveError(err))
+		}
+	} else if d.HasChangesExcept(paramCredentials, paramHardDelete, paramRotationPeriod, paramRotateTrigger) {
+		return diag.Errorf("error updating Schema Registry DEK %q: only %q, %q, %q, %q attributes can be updated for Schema Registry DEK", d.Id(), paramCredentials, paramHardDelete, paramRotationPeriod, paramRotateTrigger)
 	}
 
 	return schemaRegistryDekRead(ctx, d, meta)
 }
 
+// schemaRegistryDekRotationCustomizeDiff plans a new version of the DEK when rotate_trigger changes or when
+// the current version is older than rotation_period.
+func schemaRegistryDekRotationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	oldRotateTrigger, newRotateTrigger := diff.GetChange(paramRotateTrigger)
+	createdAt, _ := diff.GetChange(paramCreatedAt)
+	isRotationRequired, err := isDekRotationRequired(oldRotateTrigger.(string), newRotateTrigger.(string), diff.Get(paramRotationPeriod).(string), createdAt.(string), time.Now())
+	if err != nil {
+		return err
+	}
+	// An unknown rotate_trigger is only known during apply, when the DEK is rotated if it turns out to be different
+	isRotationPossible := !diff.NewValueKnown(paramRotateTrigger) && oldRotateTrigger.(string) != ""
+	if !isRotationRequired && !isRotationPossible {
+		return nil
+	}
+	for _, attribute := range []string{paramVersion, paramEncryptedKeyMaterial, paramKeyMaterial, paramCreatedAt} {
+		if err := diff.SetNewComputed(attribute); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isDekRotationConfigured(rotationPeriod, rotateTrigger string) bool {
+	return rotationPeriod != "" || rotateTrigger != ""
+}
+
+// isDekRotationRequired reports whether a new version of the DEK is needed. Setting rotate_trigger for the first time,
+// for example, on an existing DEK, doesn't rotate it, and neither does removing it.
+func isDekRotationRequired(oldRotateTrigger, newRotateTrigger, rotationPeriod, createdAt string, now time.Time) (bool, error) {
+	if oldRotateTrigger != "" && newRotateTrigger != "" && oldRotateTrigger != newRotateTrigger {
+		return true, nil
+	}
+	if rotationPeriod == "" || createdAt == "" {
+		return false, nil
+	}
+	period, err := time.ParseDuration(rotationPeriod)
+	if err != nil {
+		return false, fmt.Errorf("error parsing %q: %s", paramRotationPeriod, createDescriptiveError(err))
+	}
+	createdAtTime, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return false, fmt.Errorf("error parsing %q: %s", paramCreatedAt, createDescriptiveError(err))
+	}
+	return !now.Before(createdAtTime.Add(period)), nil
+}
+
+// rotateSchemaRegistryDek creates the version that follows the latest version of the DEK. The previous versions
+// aren't deleted, so that data that was encrypted with them can still be decrypted.
+func rotateSchemaRegistryDek(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return err
+	}
+	clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return err
+	}
+	clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return err
+	}
+
+	kekName := d.Get(paramKekName).(string)
+	subject := d.Get(paramSubjectName).(string)
+	algorithm := d.Get(paramAlgorithm).(string)
+
+	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
+	latestDek, resp, err := schemaRegistryRestClient.apiClient.DataEncryptionKeysV1Api.GetDek(schemaRegistryRestClient.apiContext(ctx), kekName, subject).Algorithm(algorithm).Execute()
+	if err != nil {
+		return fmt.Errorf("error reading the latest version: %s", createDescriptiveError(err, resp))
+	}
+
+	dekRequest := schemaregistryv1.CreateDekRequest{}
+	dekRequest.SetSubject(subject)
+	dekRequest.SetVersion(latestDek.GetVersion() + 1)
+	dekRequest.SetAlgorithm(algorithm)
+	tflog.Debug(ctx, fmt.Sprintf("Rotating Schema Registry DEK %q: creating version %d", d.Id(), dekRequest.GetVersion()), map[string]interface{}{schemaRegistryDekKey: d.Id()})
+
+	createdDek, resp, err := schemaRegistryRestClient.apiClient.DataEncryptionKeysV1Api.CreateDek(schemaRegistryRestClient.apiContext(ctx), kekName).CreateDekRequest(dekRequest).Execute()
+	if err != nil {
+		return fmt.Errorf("error creating version %d: %s", dekRequest.GetVersion(), createDescriptiveError(err, resp))
+	}
+	if _, err := setDekAttributes(d, clusterId, createdDek); err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Finished rotating Schema Registry DEK %q", d.Id()), map[string]interface{}{schemaRegistryDekKey: d.Id()})
+	return nil
+}
+
+func validateDekRotationPeriod(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	period, err := time.ParseDuration(v)
+	if err != nil || period <= 0 {
+		return nil, []error{fmt.Errorf("expected %q to be a positive duration, for example, \"720h\", got %q", k, v)}
+	}
+	return nil, nil
+}
+
 func deleteDekExecute(ctx context.Context, client *SchemaRegistryRestClient, kekName, subject, version, algorithm string, hardDelete bool) (*http.Response, error) {
 	request := client.apiClient.DataEncryptionKeysV1Api.DeleteDekVersion(client.apiContext(ctx), kekName, subject, version).Permanent(hardDelete).Algorithm(algorithm)
 	resp, err := request.Execute()
@@ -276,15 +436,21 @@ func schemaRegistryDekImport(ctx context.Context, d *schema.ResourceData, meta i
 	}
 
 	parts := strings.Split(dekId, "/")
-	if len(parts) != 5 {
-		return nil, fmt.Errorf("error importing Schema Registry DEK: invalid format: expected '<Schema Registry Cluster Id>/<Schema Registry KEK Name>/<Subject>/<Version>/<Algorithm>'")
-	}
-	d.Set(paramKekName, parts[1])
-	d.Set(paramSubjectName, parts[2])
-	if version, err := strconv.Atoi(parts[3]); err == nil {
-		d.Set(paramVersion, version)
+	switch len(parts) {
+	case 5:
+		d.Set(paramKekName, parts[1])
+		d.Set(paramSubjectName, parts[2])
+		if version, err := strconv.Atoi(parts[3]); err == nil {
+			d.Set(paramVersion, version)
+		}
+		d.Set(paramAlgorithm, parts[4])
+	case 4:
+		d.Set(paramKekName, parts[1])
+		d.Set(paramSubjectName, parts[2])
+		d.Set(paramAlgorithm, parts[3])
+	default:
+		return nil, fmt.Errorf("error importing Schema Registry DEK: invalid format: expected '<Schema Registry Cluster Id>/<Schema Registry KEK Name>/<Subject>/<Version>/<Algorithm>' or '<Schema Registry Cluster Id>/<Schema Registry KEK Name>/<Subject>/<Algorithm>' for a rotated DEK")
 	}
-	d.Set(paramAlgorithm, parts[4])
 
 	tflog.Debug(ctx, fmt.Sprintf("Imporing Schema Registry DEK %q=%q", paramId, dekId), map[string]interface{}{schemaRegistryDekKey: dekId})
 	d.MarkNewResource()
@@ -296,7 +462,6 @@ func schemaRegistryDekImport(ctx context.Context, d *schema.ResourceData, meta i
 }
 
 func setDekAttributes(d *schema.ResourceData, clusterId string, dek schemaregistryv1.Dek) (*schema.ResourceData, error) {
-	d.SetId(createDekId(clusterId, dek.GetKekName(), dek.GetSubject(), dek.GetAlgorithm(), dek.GetVersion()))
 	if err := d.Set(paramKekName, dek.GetKekName()); err != nil {
 		return nil, err
 	}
@@ -315,6 +480,9 @@ func setDekAttributes(d *schema.ResourceData, clusterId string, dek schemaregist
 	if err := d.Set(paramKeyMaterial, dek.GetKeyMaterial()); err != nil {
 		return nil, err
 	}
+	if err := d.Set(paramCreatedAt, formatDekTimestamp(dek.GetTs())); err != nil {
+		return nil, err
+	}
 
 	// Explicitly set paramHardDelete to the default value if unset
 	if _, ok := d.GetOk(paramHardDelete); !ok {
@@ -326,6 +494,18 @@ func setDekAttributes(d *schema.ResourceData, clusterId string, dek schemaregist
 	return d, nil
 }
 
+// formatDekTimestamp formats the creation time of a DEK version, which Schema Registry returns in milliseconds since the epoch.
+func formatDekTimestamp(ts int64) string {
+	if ts == 0 {
+		return ""
+	}
+	return time.UnixMilli(ts).UTC().Format(time.RFC3339)
+}
+
 func createDekId(clusterId, kekName, subject, algorithm string, version int32) string {
 	return fmt.Sprintf("%s/%s/%s/%d/%s", clusterId, kekName, subject, version, algorithm)
 }
+
+func createRotatedDekId(clusterId, kekName, subject, algorithm string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", clusterId, kekName, subject, algorithm)
+}
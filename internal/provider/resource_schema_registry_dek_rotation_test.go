@@ -0,0 +1,254 @@
+// Copyright 2024 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+func TestAccDekRotation(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	dekResponse, _ := ioutil.ReadFile("../testdata/schema_registry_dek/dek.json")
+	rotatedDekResponse, _ := ioutil.ReadFile("../testdata/schema_registry_dek/dek_v2.json")
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(createDekUrlPath)).
+		InScenario(dekRotationScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateDekHasBeenCreated).
+		WillReturn(
+			string(dekResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(dekUrlPath)).
+		InScenario(dekRotationScenarioName).
+		WhenScenarioStateIs(scenarioStateDekHasBeenCreated).
+		WillReturn(
+			string(dekResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// The latest version is read right before the rotation
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(dekSubjectUrlPath)).
+		InScenario(dekRotationScenarioName).
+		WhenScenarioStateIs(scenarioStateDekHasBeenCreated).
+		WillReturn(
+			string(dekResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(createDekUrlPath)).
+		InScenario(dekRotationScenarioName).
+		WhenScenarioStateIs(scenarioStateDekHasBeenCreated).
+		WithBodyPattern(wiremock.Contains(`"version":2`)).
+		WillSetStateTo(scenarioStateDekHasBeenRotated).
+		WillReturn(
+			string(rotatedDekResponse),
+			contentTypeJSONHeader,
+			http.StatusCreated,
+		))
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(dekV2UrlPath)).
+		InScenario(dekRotationScenarioName).
+		WhenScenarioStateIs(scenarioStateDekHasBeenRotated).
+		WillReturn(
+			string(rotatedDekResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	// A rotated DEK is imported by an ID without a version, so its latest version is read
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(dekSubjectUrlPath)).
+		InScenario(dekRotationScenarioName).
+		WhenScenarioStateIs(scenarioStateDekHasBeenRotated).
+		WillReturn(
+			string(rotatedDekResponse),
+			contentTypeJSONHeader,
+			http.StatusOK,
+		))
+
+	deleteDekStub := wiremock.Delete(wiremock.URLPathEqualTo(dekV2UrlPath)).
+		InScenario(dekRotationScenarioName).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteDekStub)
+
+	deleteDekVersionsStub := wiremock.Delete(wiremock.URLPathEqualTo(dekSubjectUrlPath)).
+		InScenario(dekRotationScenarioName).
+		WillReturn(
+			"",
+			contentTypeJSONHeader,
+			http.StatusNoContent,
+		)
+	_ = wiremockClient.StubFor(deleteDekVersionsStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: dekRotationResourceConfig(mockServerUrl, "2024-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dekLabel, "id", "111/testkek/ts/AES256_GCM"),
+					resource.TestCheckResourceAttr(dekLabel, "version", "1"),
+					resource.TestCheckResourceAttr(dekLabel, "encrypted_key_material", "tm"),
+					resource.TestCheckResourceAttr(dekLabel, "rotate_trigger", "2024-01"),
+					resource.TestCheckResourceAttr(dekLabel, "created_at", "2024-01-18T01:51:55Z"),
+				),
+			},
+			{
+				Config: dekRotationResourceConfig(mockServerUrl, "2024-02"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dekLabel, "id", "111/testkek/ts/AES256_GCM"),
+					resource.TestCheckResourceAttr(dekLabel, "version", "2"),
+					resource.TestCheckResourceAttr(dekLabel, "encrypted_key_material", "tm2"),
+					resource.TestCheckResourceAttr(dekLabel, "rotate_trigger", "2024-02"),
+					resource.TestCheckResourceAttr(dekLabel, "created_at", "2024-02-18T01:51:55Z"),
+				),
+			},
+			{
+				ResourceName:            dekLabel,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{paramRotateTrigger},
+			},
+		},
+	})
+
+	// Only the tracked version of a rotated DEK is deleted
+	checkStubCount(t, wiremockClient, deleteDekStub, fmt.Sprintf("DELETE %s", dekV2UrlPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteDekVersionsStub, fmt.Sprintf("DELETE %s", dekSubjectUrlPath), expectedCountZero)
+}
+
+func dekRotationResourceConfig(mockServerUrl, rotateTrigger string) string {
+	return fmt.Sprintf(`
+	provider "confluent" {
+	  schema_registry_id = "111"
+	  schema_registry_rest_endpoint = "%s"
+	  schema_registry_api_key       = "x"
+	  schema_registry_api_secret    = "x"
+	}
+	resource "confluent_schema_registry_dek" "mydek" {
+	  kek_name       = "testkek"
+	  subject_name   = "ts"
+	  rotate_trigger = "%s"
+	}
+	`, mockServerUrl, rotateTrigger)
+}
+
+func TestIsDekRotationRequired(t *testing.T) {
+	now := time.Date(2024, 2, 18, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name             string
+		oldRotateTrigger string
+		newRotateTrigger string
+		rotationPeriod   string
+		createdAt        string
+		expected         bool
+	}{
+		{
+			name:             "changed trigger",
+			oldRotateTrigger: "2024-01",
+			newRotateTrigger: "2024-02",
+			expected:         true,
+		},
+		{
+			name:             "unchanged trigger",
+			oldRotateTrigger: "2024-01",
+			newRotateTrigger: "2024-01",
+			expected:         false,
+		},
+		{
+			name:             "trigger set for the first time",
+			newRotateTrigger: "2024-01",
+			expected:         false,
+		},
+		{
+			name:             "removed trigger",
+			oldRotateTrigger: "2024-01",
+			expected:         false,
+		},
+		{
+			name:           "version older than the rotation period",
+			rotationPeriod: "720h",
+			createdAt:      "2024-01-18T01:51:55Z",
+			expected:       true,
+		},
+		{
+			name:           "version newer than the rotation period",
+			rotationPeriod: "1440h",
+			createdAt:      "2024-01-18T01:51:55Z",
+			expected:       false,
+		},
+		{
+			name:           "unknown creation time",
+			rotationPeriod: "720h",
+			expected:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := isDekRotationRequired(tt.oldRotateTrigger, tt.newRotateTrigger, tt.rotationPeriod, tt.createdAt, now)
+			if err != nil {
+				t.Fatalf("isDekRotationRequired() unexpected error: %s", err)
+			}
+			if actual != tt.expected {
+				t.Errorf("isDekRotationRequired() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateDekRotationPeriod(t *testing.T) {
+	for _, rotationPeriod := range []string{"720h", "90m", "1h30m"} {
+		if _, errs := validateDekRotationPeriod(rotationPeriod, paramRotationPeriod); len(errs) > 0 {
+			t.Errorf("validateDekRotationPeriod(%q) unexpected errors: %v", rotationPeriod, errs)
+		}
+	}
+	for _, rotationPeriod := range []string{"", "30d", "-1h", "0s"} {
+		if _, errs := validateDekRotationPeriod(rotationPeriod, paramRotationPeriod); len(errs) == 0 {
+			t.Errorf("validateDekRotationPeriod(%q) expected an error", rotationPeriod)
+		}
+	}
+}
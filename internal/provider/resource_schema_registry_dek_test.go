@@ -86,6 +86,7 @@ func TestAccDek(t *testing.T) {
 					resource.TestCheckResourceAttr(dekLabel, "subject_name", "ts"),
 					resource.TestCheckResourceAttr(dekLabel, "hard_delete", "true"),
 					resource.TestCheckResourceAttr(dekLabel, "key_material", ""),
+					resource.TestCheckResourceAttr(dekLabel, "created_at", "2024-01-18T01:51:55Z"),
 				),
 			},
 		},
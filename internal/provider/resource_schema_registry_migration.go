@@ -0,0 +1,636 @@
+// Copyright 2026 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	schemaregistryv1 "github.com/confluentinc/ccloud-sdk-go-v2/schema-registry/v1"
+)
+
+const (
+	schemaRegistryMigrationStatusCompleted  = "COMPLETED"
+	schemaRegistryMigrationStatusIncomplete = "INCOMPLETE"
+)
+
+// schemaRegistryMigrationSubject is a subject of the source Schema Registry cluster with everything that is copied to the destination one.
+type schemaRegistryMigrationSubject struct {
+	name    string
+	schemas []schemaregistryv1.Schema
+	// config is nil and mode is empty when the subject inherits them from the Schema Registry cluster
+	config *schemaregistryv1.Config
+	mode   string
+}
+
+func schemaRegistryMigrationResource() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: schemaRegistryMigrationCreate,
+		ReadContext:   schemaRegistryMigrationRead,
+		UpdateContext: schemaRegistryMigrationUpdate,
+		DeleteContext: schemaRegistryMigrationDelete,
+		Schema: map[string]*schema.Schema{
+			paramSchemaRegistryCluster: schemaRegistryClusterBlockSchema(),
+			paramRestEndpoint: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The REST endpoint of the source Schema Registry cluster, for example, `https://psrc-00000.us-central1.gcp.confluent.cloud:443`).",
+				ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+			},
+			paramCredentials:                      credentialsSchema(),
+			paramDestinationSchemaRegistryCluster: schemaRegistryMigrationDestinationBlockSchema(),
+			paramSubjects: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringIsNotEmpty},
+				Description: "The subjects to copy from the source Schema Registry cluster to the destination one.",
+			},
+			paramForce: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     paramForceDefaultValue,
+				Description: "Controls whether the destination subjects are switched to IMPORT mode even if they already have schemas. Defaults to `false`.",
+			},
+			paramStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the migration, `COMPLETED` or `INCOMPLETE` when only some of the schemas were copied.",
+			},
+			paramMigratedSchemas: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The schemas that were copied to the destination Schema Registry cluster, in the order they were copied.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						paramSubjectName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						paramVersion: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						paramSchemaIdentifier: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		CustomizeDiff: customdiff.Sequence(resourceCredentialBlockValidationWithOAuth, schemaRegistryMigrationCustomizeDiff),
+	}
+}
+
+func schemaRegistryMigrationDestinationBlockSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		MinItems: 1,
+		MaxItems: 1,
+		Required: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				paramId: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The ID of the destination Schema Registry cluster, for example, `lsrc-abc123`.",
+				},
+				paramRestEndpoint: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringMatch(regexp.MustCompile("^http"), "the REST endpoint must start with 'https://'"),
+				},
+				paramCredentials: {
+					Type:      schema.TypeList,
+					Optional:  true,
+					MinItems:  1,
+					MaxItems:  1,
+					Sensitive: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							paramKey: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Sensitive:    true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+							paramSecret: {
+								Type:         schema.TypeString,
+								Required:     true,
+								Sensitive:    true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schemaRegistryMigrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	source, destination, err := createSchemaRegistryMigrationRestClients(d, meta)
+	if err != nil {
+		return diag.Errorf("error creating Schema Registry Migration: %s", createDescriptiveError(err))
+	}
+	migrationId := createSchemaRegistryMigrationId(source.clusterId, destination.clusterId)
+	tflog.Debug(ctx, fmt.Sprintf("Creating new Schema Registry Migration %q of subjects %q", migrationId, d.Get(paramSubjects).(*schema.Set).List()), map[string]interface{}{schemaRegistryMigrationLoggingKey: migrationId})
+
+	migrationErr := migrateSchemaRegistrySubjects(ctx, d, meta, source, destination)
+	if migrationErr != nil && len(d.Get(paramMigratedSchemas).([]interface{})) == 0 {
+		return diag.Errorf("error creating Schema Registry Migration %q: %s", migrationId, createDescriptiveError(migrationErr))
+	}
+
+	// The schemas that have been copied are saved to TF state even if the migration failed afterwards, since they can't be
+	// registered again without force. The migration is resumed by the next 'terraform apply' (see schemaRegistryMigrationCustomizeDiff
+	// and schemaRegistryMigrationUpdate), which only copies the remaining schemas.
+	d.SetId(migrationId)
+	tflog.Debug(ctx, fmt.Sprintf("Finished creating Schema Registry Migration %q", d.Id()), map[string]interface{}{schemaRegistryMigrationLoggingKey: d.Id()})
+
+	diags := schemaRegistryMigrationRead(ctx, d, meta)
+	if migrationErr != nil {
+		// Returning an error from Create would mark the resource as tainted, and replacing it would start the migration over.
+		diags = append(diags, schemaRegistryMigrationPartiallyCompletedWarning(len(d.Get(paramMigratedSchemas).([]interface{})), migrationErr))
+	}
+	return diags
+}
+
+func schemaRegistryMigrationPartiallyCompletedWarning(migratedCount int, err error) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Warning,
+		Summary:  "Schema Registry Migration was completed partially",
+		Detail:   fmt.Sprintf("The migration stopped after copying %d schemas: %s\n\nThe copied schemas are saved to the Terraform state. Re-run 'terraform apply' to resume the migration.", migratedCount, createDescriptiveError(err)),
+	}
+}
+
+// schemaRegistryMigrationCustomizeDiff plans an update that resumes the migration when a previous 'terraform apply'
+// managed to copy only some of the schemas.
+func schemaRegistryMigrationCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || diff.Get(paramStatus).(string) == schemaRegistryMigrationStatusCompleted {
+		return nil
+	}
+	if err := diff.SetNewComputed(paramStatus); err != nil {
+		return err
+	}
+	return diff.SetNewComputed(paramMigratedSchemas)
+}
+
+// schemaRegistryMigrationRead doesn't read anything: the migration is a one-off copy and the destination subjects
+// are expected to evolve independently afterwards.
+func schemaRegistryMigrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Reading Schema Registry Migration %q", d.Id()), map[string]interface{}{schemaRegistryMigrationLoggingKey: d.Id()})
+	tflog.Debug(ctx, fmt.Sprintf("Finished reading Schema Registry Migration %q", d.Id()), map[string]interface{}{schemaRegistryMigrationLoggingKey: d.Id()})
+	return nil
+}
+
+func schemaRegistryMigrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChangesExcept(paramCredentials, paramDestinationSchemaRegistryCluster, paramStatus, paramMigratedSchemas) {
+		return diag.Errorf("error updating Schema Registry Migration %q: only %q and %q blocks can be updated for Schema Registry Migration", d.Id(), paramCredentials, fmt.Sprintf("%s.0.%s", paramDestinationSchemaRegistryCluster, paramCredentials))
+	}
+	if !d.HasChange(paramStatus) {
+		return schemaRegistryMigrationRead(ctx, d, meta)
+	}
+
+	source, destination, err := createSchemaRegistryMigrationRestClients(d, meta)
+	if err != nil {
+		return diag.Errorf("error updating Schema Registry Migration %q: %s", d.Id(), createDescriptiveError(err))
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Resuming Schema Registry Migration %q", d.Id()), map[string]interface{}{schemaRegistryMigrationLoggingKey: d.Id()})
+	migrationErr := migrateSchemaRegistrySubjects(ctx, d, meta, source, destination)
+	tflog.Debug(ctx, fmt.Sprintf("Finished resuming Schema Registry Migration %q", d.Id()), map[string]interface{}{schemaRegistryMigrationLoggingKey: d.Id()})
+
+	diags := schemaRegistryMigrationRead(ctx, d, meta)
+	if migrationErr != nil {
+		diags = append(diags, schemaRegistryMigrationPartiallyCompletedWarning(len(d.Get(paramMigratedSchemas).([]interface{})), migrationErr))
+	}
+	return diags
+}
+
+// schemaRegistryMigrationDelete only removes the migration from TF state, the copied schemas stay in the destination Schema Registry cluster.
+func schemaRegistryMigrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, fmt.Sprintf("Deleting Schema Registry Migration %q", d.Id()), map[string]interface{}{schemaRegistryMigrationLoggingKey: d.Id()})
+	tflog.Debug(ctx, fmt.Sprintf("Finished deleting Schema Registry Migration %q", d.Id()), map[string]interface{}{schemaRegistryMigrationLoggingKey: d.Id()})
+	return nil
+}
+
+// migrateSchemaRegistrySubjects copies the subjects, skipping the schemas that a previous attempt has copied already,
+// and saves the copied schemas and the status of the migration to TF state, also when it fails.
+func migrateSchemaRegistrySubjects(ctx context.Context, d *schema.ResourceData, meta interface{}, source, destination *SchemaRegistryRestClient) error {
+	if err := d.Set(paramStatus, schemaRegistryMigrationStatusIncomplete); err != nil {
+		return err
+	}
+	subjectNames := convertToStringSlice(d.Get(paramSubjects).(*schema.Set).List())
+	sort.Strings(subjectNames)
+	force := d.Get(paramForce).(bool)
+	// The planned value is unknown when the migration is resumed, see schemaRegistryMigrationCustomizeDiff
+	previouslyMigratedSchemas, _ := d.GetChange(paramMigratedSchemas)
+	migratedSchemas := previouslyMigratedSchemas.([]interface{})
+
+	subjects, err := loadSchemaRegistryMigrationSubjectsWithReferences(ctx, source, subjectNames)
+	if err != nil {
+		return err
+	}
+	allSubjectNames := make([]string, len(subjects))
+	for i, subject := range subjects {
+		allSubjectNames[i] = subject.name
+	}
+	previousDestinationModes, err := loadSubjectModes(ctx, destination, allSubjectNames)
+	if err != nil {
+		return fmt.Errorf("error reading destination Subject Modes: %s", createDescriptiveError(err))
+	}
+
+	// IMPORT mode lets schemas be registered with the IDs and versions they have in the source Schema Registry cluster.
+	// The destination subjects that a previous attempt has copied schemas to can only be switched to it with force.
+	for _, subject := range subjects {
+		if err := updateSubjectModeOrRevertToGlobal(ctx, destination, subject.name, modeImport, force || hasMigratedSchemas(migratedSchemas, subject.name)); err != nil {
+			restoreSubjectModes(ctx, destination, previousDestinationModes)
+			return fmt.Errorf("error switching destination Subject %q to %s mode: %s", subject.name, modeImport, createDescriptiveError(err))
+		}
+	}
+	SleepIfNotTestMode(schemaRegistryAPIWaitAfterCreateOrDelete, meta.(*Client).isAcceptanceTestMode, meta.(*Client).isLiveProductionTestMode)
+
+	migratedSchemas, err = copySchemaRegistryMigrationSubjects(ctx, destination, subjects, migratedSchemas)
+	if setErr := d.Set(paramMigratedSchemas, migratedSchemas); setErr != nil {
+		return setErr
+	}
+	if err != nil {
+		restoreSubjectModes(ctx, destination, previousDestinationModes)
+		return err
+	}
+
+	// Flip the destination subjects to the modes of the source ones, now that their schemas are there
+	for _, subject := range subjects {
+		if err := updateSubjectModeOrRevertToGlobal(ctx, destination, subject.name, subject.mode, force); err != nil {
+			return fmt.Errorf("error restoring the mode of destination Subject %q: %s", subject.name, createDescriptiveError(err))
+		}
+	}
+	SleepIfNotTestMode(schemaRegistryAPIWaitAfterCreateOrDelete, meta.(*Client).isAcceptanceTestMode, meta.(*Client).isLiveProductionTestMode)
+
+	if err := verifySchemaRegistryMigration(ctx, destination, subjects); err != nil {
+		return fmt.Errorf("error verifying Schema Registry Migration: %s", createDescriptiveError(err))
+	}
+	return d.Set(paramStatus, schemaRegistryMigrationStatusCompleted)
+}
+
+func createSchemaRegistryMigrationRestClients(d *schema.ResourceData, meta interface{}) (*SchemaRegistryRestClient, *SchemaRegistryRestClient, error) {
+	restEndpoint, err := extractSchemaRegistryRestEndpoint(meta.(*Client), d, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	clusterId, err := extractSchemaRegistryClusterId(meta.(*Client), d, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	clusterApiKey, clusterApiSecret, err := extractSchemaRegistryClusterApiKeyAndApiSecret(meta.(*Client), d, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	source := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
+	destination, err := createDestinationSchemaRegistryRestClient(d, meta)
+	if err != nil {
+		return nil, nil, err
+	}
+	return source, destination, nil
+}
+
+func createDestinationSchemaRegistryRestClient(d *schema.ResourceData, meta interface{}) (*SchemaRegistryRestClient, error) {
+	client := meta.(*Client)
+	clusterId := extractStringValueFromBlock(d, paramDestinationSchemaRegistryCluster, paramId)
+	restEndpoint := extractStringValueFromBlock(d, paramDestinationSchemaRegistryCluster, paramRestEndpoint)
+	clusterApiKey := extractStringValueFromNestedBlock(d, paramDestinationSchemaRegistryCluster, paramCredentials, paramKey)
+	clusterApiSecret := extractStringValueFromNestedBlock(d, paramDestinationSchemaRegistryCluster, paramCredentials, paramSecret)
+	if !client.isOAuthEnabled && (clusterApiKey == "" || clusterApiSecret == "") {
+		return nil, fmt.Errorf("%q block must be set in %q block", paramCredentials, paramDestinationSchemaRegistryCluster)
+	}
+	// The destination Schema Registry cluster is never the one from the provider block
+	return client.schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, false, client.oauthToken), nil
+}
+
+// loadSchemaRegistryMigrationSubjectsWithReferences loads the subjects along with the subjects that their schemas reference,
+// directly or not, since a schema can only be registered once the schemas it references are.
+func loadSchemaRegistryMigrationSubjectsWithReferences(ctx context.Context, c *SchemaRegistryRestClient, subjectNames []string) ([]schemaRegistryMigrationSubject, error) {
+	var subjects []schemaRegistryMigrationSubject
+	for len(subjectNames) > 0 {
+		loadedSubjects, err := loadSchemaRegistryMigrationSubjects(ctx, c, subjectNames)
+		if err != nil {
+			return nil, err
+		}
+		subjects = append(subjects, loadedSubjects...)
+		subjectNames = findUnloadedReferencedSubjects(subjects)
+		if len(subjectNames) > 0 {
+			tflog.Debug(ctx, fmt.Sprintf("Loading referenced source Subjects %q", subjectNames))
+		}
+	}
+	return subjects, nil
+}
+
+// findUnloadedReferencedSubjects returns the sorted names of the subjects that the schemas of the subjects reference but that aren't among them.
+func findUnloadedReferencedSubjects(subjects []schemaRegistryMigrationSubject) []string {
+	loadedSubjectNames := make(map[string]bool)
+	for _, subject := range subjects {
+		loadedSubjectNames[subject.name] = true
+	}
+	var referencedSubjectNames []string
+	for _, subject := range subjects {
+		for _, srSchema := range subject.schemas {
+			for _, reference := range srSchema.GetReferences() {
+				if !loadedSubjectNames[reference.GetSubject()] {
+					loadedSubjectNames[reference.GetSubject()] = true
+					referencedSubjectNames = append(referencedSubjectNames, reference.GetSubject())
+				}
+			}
+		}
+	}
+	sort.Strings(referencedSubjectNames)
+	return referencedSubjectNames
+}
+
+// loadSchemaRegistryMigrationSubjects loads the active versions of the subjects along with their subject-level config and mode.
+func loadSchemaRegistryMigrationSubjects(ctx context.Context, c *SchemaRegistryRestClient, subjectNames []string) ([]schemaRegistryMigrationSubject, error) {
+	subjects := make([]schemaRegistryMigrationSubject, len(subjectNames))
+	for i, subjectName := range subjectNames {
+		versions, resp, err := c.apiClient.SubjectsV1Api.ListVersions(c.apiContext(ctx), subjectName).Execute()
+		if err != nil {
+			return nil, fmt.Errorf("error reading versions of source Subject %q: %s", subjectName, createDescriptiveError(err, resp))
+		}
+		subject := schemaRegistryMigrationSubject{name: subjectName}
+		for _, version := range sortedVersions(versions) {
+			srSchema, resp, err := c.apiClient.SubjectsV1Api.GetSchemaByVersion(c.apiContext(ctx), subjectName, fmt.Sprint(version)).Execute()
+			if err != nil {
+				return nil, fmt.Errorf("error reading version %d of source Subject %q: %s", version, subjectName, createDescriptiveError(err, resp))
+			}
+			srSchema.SetSubject(subjectName)
+			subject.schemas = append(subject.schemas, srSchema)
+		}
+
+		config, resp, err := c.apiClient.ConfigV1Api.GetSubjectLevelConfig(c.apiContext(ctx), subjectName).DefaultToGlobal(false).Execute()
+		if err != nil && !ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+			return nil, fmt.Errorf("error reading Subject Config of source Subject %q: %s", subjectName, createDescriptiveError(err, resp))
+		}
+		if err == nil {
+			subject.config = &config
+		}
+
+		mode, err := loadSubjectMode(ctx, c, subjectName)
+		if err != nil {
+			return nil, fmt.Errorf("error reading Subject Mode of source Subject %q: %s", subjectName, createDescriptiveError(err))
+		}
+		subject.mode = mode
+
+		subjectJson, err := json.Marshal(subject.schemas)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling %#v to json: %s", subject.schemas, createDescriptiveError(err))
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Fetched source Subject %q with mode %q: %s", subjectName, subject.mode, subjectJson))
+		subjects[i] = subject
+	}
+	return subjects, nil
+}
+
+// loadSubjectMode returns the subject-level mode of a subject, or an empty string when it inherits the mode of the Schema Registry cluster.
+func loadSubjectMode(ctx context.Context, c *SchemaRegistryRestClient, subjectName string) (string, error) {
+	mode, resp, err := c.apiClient.ModesV1Api.GetMode(c.apiContext(ctx), subjectName).DefaultToGlobal(false).Execute()
+	if ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+		return "", nil
+	}
+	if err != nil {
+		return "", createDescriptiveError(err, resp)
+	}
+	return mode.GetMode(), nil
+}
+
+func loadSubjectModes(ctx context.Context, c *SchemaRegistryRestClient, subjectNames []string) (map[string]string, error) {
+	modes := make(map[string]string, len(subjectNames))
+	for _, subjectName := range subjectNames {
+		mode, err := loadSubjectMode(ctx, c, subjectName)
+		if err != nil {
+			return nil, fmt.Errorf("error reading Subject Mode of Subject %q: %s", subjectName, createDescriptiveError(err))
+		}
+		modes[subjectName] = mode
+	}
+	return modes, nil
+}
+
+// updateSubjectModeOrRevertToGlobal sets the subject-level mode of a subject, or deletes it when mode is empty,
+// so that the subject inherits the mode of the Schema Registry cluster.
+func updateSubjectModeOrRevertToGlobal(ctx context.Context, c *SchemaRegistryRestClient, subjectName, mode string, force bool) error {
+	if mode == "" {
+		_, resp, err := c.apiClient.ModesV1Api.DeleteSubjectMode(c.apiContext(ctx), subjectName).Execute()
+		if err != nil && !ResponseHasExpectedStatusCode(resp, http.StatusNotFound) {
+			return createDescriptiveError(err, resp)
+		}
+		return nil
+	}
+	updateModeRequest := schemaregistryv1.NewModeUpdateRequest()
+	updateModeRequest.SetMode(mode)
+	_, resp, err := executeSubjectModeUpdate(ctx, c, updateModeRequest, subjectName, force)
+	if err != nil {
+		return createDescriptiveError(err, resp)
+	}
+	return nil
+}
+
+// restoreSubjectModes puts the destination subjects back into the modes they had before a failed migration.
+// It's best-effort, so that the error that made the migration fail is the one that is reported.
+func restoreSubjectModes(ctx context.Context, c *SchemaRegistryRestClient, modes map[string]string) {
+	for subjectName, mode := range modes {
+		if err := updateSubjectModeOrRevertToGlobal(ctx, c, subjectName, mode, false); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Error restoring the mode of destination Subject %q to %q: %s", subjectName, mode, createDescriptiveError(err)))
+		}
+	}
+}
+
+// copySchemaRegistryMigrationSubjects registers the schemas of the subjects that aren't in migratedSchemas yet, then copies
+// their subject-level configs, and returns migratedSchemas with the schemas it has registered, also when it fails.
+// The configs are copied last, so that their default and override metadata and rule sets aren't applied to the copied schemas twice.
+func copySchemaRegistryMigrationSubjects(ctx context.Context, c *SchemaRegistryRestClient, subjects []schemaRegistryMigrationSubject, migratedSchemas []interface{}) ([]interface{}, error) {
+	for _, srSchema := range sortSchemasForMigration(subjects) {
+		if isSchemaMigrated(migratedSchemas, srSchema) {
+			continue
+		}
+		registerSchemaRequest := buildMigrationRegisterSchemaRequest(srSchema)
+		registerSchemaRequestJson, err := json.Marshal(registerSchemaRequest)
+		if err != nil {
+			return migratedSchemas, fmt.Errorf("error marshaling %#v to json: %s", registerSchemaRequest, createDescriptiveError(err))
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Registering version %d of Subject %q: %s", srSchema.GetVersion(), srSchema.GetSubject(), registerSchemaRequestJson))
+
+		_, resp, err := executeSchemaCreate(ctx, c, registerSchemaRequest, srSchema.GetSubject())
+		if err != nil {
+			return migratedSchemas, fmt.Errorf("error registering version %d of Subject %q with ID %d: %s", srSchema.GetVersion(), srSchema.GetSubject(), srSchema.GetId(), createDescriptiveError(err, resp))
+		}
+		migratedSchemas = append(migratedSchemas, map[string]interface{}{
+			paramSubjectName:      srSchema.GetSubject(),
+			paramVersion:          int(srSchema.GetVersion()),
+			paramSchemaIdentifier: int(srSchema.GetId()),
+		})
+	}
+
+	for _, subject := range subjects {
+		if subject.config == nil {
+			continue
+		}
+		_, resp, err := executeSubjectConfigUpdate(ctx, c, buildMigrationConfigUpdateRequest(*subject.config), subject.name)
+		if err != nil {
+			return migratedSchemas, fmt.Errorf("error copying Subject Config of Subject %q: %s", subject.name, createDescriptiveError(err, resp))
+		}
+	}
+	return migratedSchemas, nil
+}
+
+func isSchemaMigrated(migratedSchemas []interface{}, srSchema schemaregistryv1.Schema) bool {
+	for _, migratedSchema := range migratedSchemas {
+		migratedSchemaMap := migratedSchema.(map[string]interface{})
+		if migratedSchemaMap[paramSubjectName].(string) == srSchema.GetSubject() && migratedSchemaMap[paramVersion].(int) == int(srSchema.GetVersion()) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasMigratedSchemas(migratedSchemas []interface{}, subjectName string) bool {
+	for _, migratedSchema := range migratedSchemas {
+		if migratedSchema.(map[string]interface{})[paramSubjectName].(string) == subjectName {
+			return true
+		}
+	}
+	return false
+}
+
+// sortSchemasForMigration orders the schemas of all subjects by ID, so that referenced schemas, which are registered first
+// in the source Schema Registry cluster and so have lower IDs, are registered before the schemas that reference them.
+func sortSchemasForMigration(subjects []schemaRegistryMigrationSubject) []schemaregistryv1.Schema {
+	var schemas []schemaregistryv1.Schema
+	for _, subject := range subjects {
+		schemas = append(schemas, subject.schemas...)
+	}
+	sort.SliceStable(schemas, func(i, j int) bool {
+		if schemas[i].GetId() != schemas[j].GetId() {
+			return schemas[i].GetId() < schemas[j].GetId()
+		}
+		if schemas[i].GetSubject() != schemas[j].GetSubject() {
+			return schemas[i].GetSubject() < schemas[j].GetSubject()
+		}
+		return schemas[i].GetVersion() < schemas[j].GetVersion()
+	})
+	return schemas
+}
+
+func buildMigrationRegisterSchemaRequest(srSchema schemaregistryv1.Schema) *schemaregistryv1.RegisterSchemaRequest {
+	registerSchemaRequest := schemaregistryv1.NewRegisterSchemaRequest()
+	registerSchemaRequest.SetId(srSchema.GetId())
+	registerSchemaRequest.SetVersion(srSchema.GetVersion())
+	registerSchemaRequest.SetSchema(srSchema.GetSchema())
+	if srSchema.HasSchemaType() {
+		registerSchemaRequest.SetSchemaType(srSchema.GetSchemaType())
+	}
+	if srSchema.HasReferences() {
+		registerSchemaRequest.SetReferences(srSchema.GetReferences())
+	}
+	if metadata, ok := srSchema.GetMetadataOk(); ok && metadata != nil {
+		registerSchemaRequest.SetMetadata(*metadata)
+	}
+	if ruleSet, ok := srSchema.GetRuleSetOk(); ok && ruleSet != nil {
+		registerSchemaRequest.SetRuleSet(*ruleSet)
+	}
+	if schemaTags := srSchema.GetSchemaTags(); len(schemaTags) > 0 {
+		registerSchemaRequest.SetSchemaTagsToAdd(schemaTags)
+	}
+	return registerSchemaRequest
+}
+
+func buildMigrationConfigUpdateRequest(config schemaregistryv1.Config) *schemaregistryv1.ConfigUpdateRequest {
+	return &schemaregistryv1.ConfigUpdateRequest{
+		Alias:               config.Alias,
+		AliasForDeks:        config.AliasForDeks,
+		Normalize:           config.Normalize,
+		ValidateFields:      config.ValidateFields,
+		ValidateNewSchemas:  config.ValidateNewSchemas,
+		ValidateRules:       config.ValidateRules,
+		Compatibility:       config.CompatibilityLevel,
+		CompatibilityPolicy: config.CompatibilityPolicy,
+		CompatibilityGroup:  config.CompatibilityGroup,
+		DefaultMetadata:     config.DefaultMetadata,
+		OverrideMetadata:    config.OverrideMetadata,
+		DefaultRuleSet:      config.DefaultRuleSet,
+		OverrideRuleSet:     config.OverrideRuleSet,
+	}
+}
+
+// verifySchemaRegistryMigration checks that every copied version has the ID, type and definition it has in the source
+// Schema Registry cluster, and that the destination subjects are back in the modes of the source ones.
+func verifySchemaRegistryMigration(ctx context.Context, c *SchemaRegistryRestClient, subjects []schemaRegistryMigrationSubject) error {
+	var issues []string
+	for _, subject := range subjects {
+		for _, expected := range subject.schemas {
+			actual, resp, err := c.apiClient.SubjectsV1Api.GetSchemaByVersion(c.apiContext(ctx), subject.name, fmt.Sprint(expected.GetVersion())).Execute()
+			if err != nil {
+				return fmt.Errorf("error reading version %d of destination Subject %q: %s", expected.GetVersion(), subject.name, createDescriptiveError(err, resp))
+			}
+			issues = append(issues, compareMigratedSchemas(expected, actual)...)
+		}
+
+		mode, err := loadSubjectMode(ctx, c, subject.name)
+		if err != nil {
+			return fmt.Errorf("error reading Subject Mode of destination Subject %q: %s", subject.name, createDescriptiveError(err))
+		}
+		if mode != subject.mode {
+			issues = append(issues, fmt.Sprintf("Subject %q: expected mode %q, got %q", subject.name, subject.mode, mode))
+		}
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("the destination Schema Registry cluster doesn't match the source one:\n- %s", strings.Join(issues, "\n- "))
+	}
+	return nil
+}
+
+func compareMigratedSchemas(expected, actual schemaregistryv1.Schema) []string {
+	var issues []string
+	prefix := fmt.Sprintf("version %d of Subject %q", expected.GetVersion(), expected.GetSubject())
+	if actual.GetId() != expected.GetId() {
+		issues = append(issues, fmt.Sprintf("%s: expected ID %d, got %d", prefix, expected.GetId(), actual.GetId()))
+	}
+	if actual.GetSchemaType() != expected.GetSchemaType() {
+		issues = append(issues, fmt.Sprintf("%s: expected schema type %q, got %q", prefix, expected.GetSchemaType(), actual.GetSchemaType()))
+	}
+	if actual.GetSchema() != expected.GetSchema() {
+		issues = append(issues, fmt.Sprintf("%s: the schema definitions are different", prefix))
+	}
+	return issues
+}
+
+// createSchemaRegistryMigrationId generates an ID that is unique even for several migrations between the same Schema Registry clusters.
+func createSchemaRegistryMigrationId(sourceClusterId, destinationClusterId string) string {
+	return fmt.Sprintf("%s/%s/%s", sourceClusterId, destinationClusterId, uuid.New().String())
+}
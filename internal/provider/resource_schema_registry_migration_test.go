@@ -0,0 +1,279 @@
+// Copyright 2026 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+
+	schemaregistryv1 "github.com/confluentinc/ccloud-sdk-go-v2/schema-registry/v1"
+)
+
+func TestAccSchemaRegistryMigration(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	versions, _ := ioutil.ReadFile("../testdata/schema_registry_migration/read_versions.json")
+	firstVersion, _ := ioutil.ReadFile("../testdata/schema_registry_migration/read_schema_v1.json")
+	secondVersion, _ := ioutil.ReadFile("../testdata/schema_registry_migration/read_schema_v2.json")
+	subjectConfig, _ := ioutil.ReadFile("../testdata/schema_registry_migration/read_subject_config.json")
+	subjectModeNotFound, _ := ioutil.ReadFile("../testdata/schema_registry_migration/read_subject_mode_not_found.json")
+
+	// The source Schema Registry cluster
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/subjects/test-value/versions")).
+		WillReturn(string(versions), contentTypeJSONHeader, http.StatusOK))
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/subjects/test-value/versions/1")).
+		WillReturn(string(firstVersion), contentTypeJSONHeader, http.StatusOK))
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/subjects/test-value/versions/2")).
+		WillReturn(string(secondVersion), contentTypeJSONHeader, http.StatusOK))
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/config/test-value")).
+		WillReturn(string(subjectConfig), contentTypeJSONHeader, http.StatusOK))
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/mode/test-value")).
+		WillReturn(string(subjectModeNotFound), contentTypeJSONHeader, http.StatusNotFound))
+
+	// The destination Schema Registry cluster, where the subject doesn't have a subject-level mode before and after the migration
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(schemaRegistryMigrationDestinationPath+"/mode/test-value")).
+		WillReturn(string(subjectModeNotFound), contentTypeJSONHeader, http.StatusNotFound))
+	updateModeStub := wiremock.Put(wiremock.URLPathEqualTo(schemaRegistryMigrationDestinationPath+"/mode/test-value")).
+		WithBodyPattern(wiremock.Contains(`"mode":"IMPORT"`)).
+		WillReturn(`{"mode":"IMPORT"}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(updateModeStub)
+	registerFirstVersionStub := wiremock.Post(wiremock.URLPathEqualTo(schemaRegistryMigrationDestinationPath+"/subjects/test-value/versions")).
+		WithBodyPattern(wiremock.Contains(`"id":100001`)).
+		WillReturn(`{"id":100001}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(registerFirstVersionStub)
+	// The first attempt to register the second version fails
+	failSecondVersionStub := wiremock.Post(wiremock.URLPathEqualTo(schemaRegistryMigrationDestinationPath+"/subjects/test-value/versions")).
+		InScenario(schemaRegistryMigrationScenarioName).
+		WhenScenarioStateIs(wiremock.ScenarioStateStarted).
+		WillSetStateTo(scenarioStateSchemaRegistryMigrationHasFailed).
+		WithBodyPattern(wiremock.Contains(`"id":100002`)).
+		WillReturn(`{"error_code": 42205, "message": "Subject test-value is not in import mode"}`, contentTypeJSONHeader, http.StatusUnprocessableEntity)
+	_ = wiremockClient.StubFor(failSecondVersionStub)
+	registerSecondVersionStub := wiremock.Post(wiremock.URLPathEqualTo(schemaRegistryMigrationDestinationPath+"/subjects/test-value/versions")).
+		InScenario(schemaRegistryMigrationScenarioName).
+		WhenScenarioStateIs(scenarioStateSchemaRegistryMigrationHasFailed).
+		WithBodyPattern(wiremock.Contains(`"id":100002`)).
+		WithBodyPattern(wiremock.Contains(`"Test.ssn":["PII"]`)).
+		WillReturn(`{"id":100002}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(registerSecondVersionStub)
+	updateConfigStub := wiremock.Put(wiremock.URLPathEqualTo(schemaRegistryMigrationDestinationPath+"/config/test-value")).
+		WithBodyPattern(wiremock.Contains(`"compatibility":"FULL"`)).
+		WillReturn(`{"compatibility":"FULL"}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(updateConfigStub)
+	deleteModeStub := wiremock.Delete(wiremock.URLPathEqualTo(schemaRegistryMigrationDestinationPath+"/mode/test-value")).
+		WillReturn(`{"mode":"IMPORT"}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(deleteModeStub)
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(schemaRegistryMigrationDestinationPath+"/subjects/test-value/versions/1")).
+		WillReturn(string(firstVersion), contentTypeJSONHeader, http.StatusOK))
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(schemaRegistryMigrationDestinationPath+"/subjects/test-value/versions/2")).
+		WillReturn(string(secondVersion), contentTypeJSONHeader, http.StatusOK))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Only the first version is copied, and it's saved to TF state
+				Config: testAccCheckSchemaRegistryMigrationConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(schemaRegistryMigrationLabel, "id", regexp.MustCompile(fmt.Sprintf("^%s/lsrc-dest/[0-9a-f-]{36}$", testStreamGovernanceClusterId))),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "status", schemaRegistryMigrationStatusIncomplete),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "migrated_schemas.#", "1"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "migrated_schemas.0.subject_name", "test-value"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "migrated_schemas.0.version", "1"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "migrated_schemas.0.schema_identifier", "100001"),
+				),
+				// The migration is planned to be resumed
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// Only the remaining version is copied
+				Config: testAccCheckSchemaRegistryMigrationConfig(mockServerUrl),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(schemaRegistryMigrationLabel, "id", regexp.MustCompile(fmt.Sprintf("^%s/lsrc-dest/[0-9a-f-]{36}$", testStreamGovernanceClusterId))),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "status", schemaRegistryMigrationStatusCompleted),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "subjects.#", "1"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "subjects.0", "test-value"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "force", "false"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "migrated_schemas.#", "2"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "migrated_schemas.0.subject_name", "test-value"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "migrated_schemas.0.version", "1"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "migrated_schemas.0.schema_identifier", "100001"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "migrated_schemas.1.subject_name", "test-value"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "migrated_schemas.1.version", "2"),
+					resource.TestCheckResourceAttr(schemaRegistryMigrationLabel, "migrated_schemas.1.schema_identifier", "100002"),
+				),
+			},
+		},
+	})
+
+	// The subject is switched to IMPORT mode by both attempts
+	checkStubCount(t, wiremockClient, updateModeStub, fmt.Sprintf("PUT %s/mode/test-value", schemaRegistryMigrationDestinationPath), expectedCountTwo)
+	checkStubCount(t, wiremockClient, registerFirstVersionStub, fmt.Sprintf("POST %s/subjects/test-value/versions", schemaRegistryMigrationDestinationPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, failSecondVersionStub, fmt.Sprintf("POST %s/subjects/test-value/versions", schemaRegistryMigrationDestinationPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, registerSecondVersionStub, fmt.Sprintf("POST %s/subjects/test-value/versions", schemaRegistryMigrationDestinationPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, updateConfigStub, fmt.Sprintf("PUT %s/config/test-value", schemaRegistryMigrationDestinationPath), expectedCountOne)
+	// Deleting the subject-level mode flips the subject back to the mode of the destination Schema Registry cluster, like in the source one,
+	// after the failed attempt and after the migration
+	checkStubCount(t, wiremockClient, deleteModeStub, fmt.Sprintf("DELETE %s/mode/test-value", schemaRegistryMigrationDestinationPath), expectedCountTwo)
+}
+
+func testAccCheckSchemaRegistryMigrationConfig(mockServerUrl string) string {
+	return fmt.Sprintf(`
+	resource "confluent_schema_registry_migration" "main" {
+	  schema_registry_cluster {
+	    id = "%s"
+	  }
+	  rest_endpoint = "%s"
+	  credentials {
+	    key    = "%s"
+	    secret = "%s"
+	  }
+
+	  destination_schema_registry_cluster {
+	    id            = "lsrc-dest"
+	    rest_endpoint = "%s%s"
+	    credentials {
+	      key    = "%s"
+	      secret = "%s"
+	    }
+	  }
+
+	  subjects = ["test-value"]
+	}
+	`, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret,
+		mockServerUrl, schemaRegistryMigrationDestinationPath, testSchemaRegistryKey, testSchemaRegistrySecret)
+}
+
+func TestSortSchemasForMigration(t *testing.T) {
+	newSchema := func(subject string, version, id int32) schemaregistryv1.Schema {
+		srSchema := schemaregistryv1.NewSchema()
+		srSchema.SetSubject(subject)
+		srSchema.SetVersion(version)
+		srSchema.SetId(id)
+		return *srSchema
+	}
+	subjects := []schemaRegistryMigrationSubject{
+		{name: "orders-value", schemas: []schemaregistryv1.Schema{newSchema("orders-value", 1, 100003), newSchema("orders-value", 2, 100001)}},
+		{name: "address", schemas: []schemaregistryv1.Schema{newSchema("address", 1, 100001), newSchema("address", 2, 100002)}},
+	}
+	expected := []string{"address/1/100001", "orders-value/2/100001", "address/2/100002", "orders-value/1/100003"}
+
+	var actual []string
+	for _, srSchema := range sortSchemasForMigration(subjects) {
+		actual = append(actual, fmt.Sprintf("%s/%d/%d", srSchema.GetSubject(), srSchema.GetVersion(), srSchema.GetId()))
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("sortSchemasForMigration() = %v, expected %v", actual, expected)
+	}
+}
+
+func TestFindUnloadedReferencedSubjects(t *testing.T) {
+	newSchema := func(subject string, referencedSubjects ...string) schemaregistryv1.Schema {
+		srSchema := schemaregistryv1.NewSchema()
+		srSchema.SetSubject(subject)
+		var references []schemaregistryv1.SchemaReference
+		for _, referencedSubject := range referencedSubjects {
+			references = append(references, schemaregistryv1.SchemaReference{Name: schemaregistryv1.PtrString(referencedSubject + ".proto"), Subject: schemaregistryv1.PtrString(referencedSubject), Version: schemaregistryv1.PtrInt32(1)})
+		}
+		srSchema.SetReferences(references)
+		return *srSchema
+	}
+	subjects := []schemaRegistryMigrationSubject{
+		{name: "orders-value", schemas: []schemaregistryv1.Schema{newSchema("orders-value", "customer"), newSchema("orders-value", "customer", "address")}},
+		{name: "address", schemas: []schemaregistryv1.Schema{newSchema("address", "country")}},
+	}
+	expected := []string{"country", "customer"}
+	if actual := findUnloadedReferencedSubjects(subjects); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("findUnloadedReferencedSubjects() = %v, expected %v", actual, expected)
+	}
+	if actual := findUnloadedReferencedSubjects(subjects[1:2]); !reflect.DeepEqual(actual, []string{"country"}) {
+		t.Errorf("findUnloadedReferencedSubjects() = %v, expected %v", actual, []string{"country"})
+	}
+}
+
+func TestBuildMigrationRegisterSchemaRequest(t *testing.T) {
+	srSchema := schemaregistryv1.NewSchema()
+	srSchema.SetSubject("orders-value")
+	srSchema.SetVersion(3)
+	srSchema.SetId(100005)
+	srSchema.SetSchemaType("PROTOBUF")
+	srSchema.SetSchema("syntax = \"proto3\";")
+	srSchema.SetReferences([]schemaregistryv1.SchemaReference{{Name: schemaregistryv1.PtrString("address.proto"), Subject: schemaregistryv1.PtrString("address"), Version: schemaregistryv1.PtrInt32(1)}})
+	metadata := schemaregistryv1.NewMetadata()
+	metadata.SetTags(map[string][]string{"Order.ssn": {"PII"}})
+	srSchema.SetMetadata(*metadata)
+
+	request := buildMigrationRegisterSchemaRequest(*srSchema)
+	if request.GetId() != 100005 || request.GetVersion() != 3 || request.GetSchemaType() != "PROTOBUF" || request.GetSchema() != srSchema.GetSchema() {
+		t.Errorf("buildMigrationRegisterSchemaRequest() = %#v, expected the ID, version, type and definition of %#v", request, srSchema)
+	}
+	if !reflect.DeepEqual(request.GetReferences(), srSchema.GetReferences()) {
+		t.Errorf("buildMigrationRegisterSchemaRequest() references = %#v, expected %#v", request.GetReferences(), srSchema.GetReferences())
+	}
+	if requestMetadata := request.GetMetadata(); !reflect.DeepEqual(requestMetadata.GetTags(), metadata.GetTags()) {
+		t.Errorf("buildMigrationRegisterSchemaRequest() tags = %#v, expected %#v", requestMetadata.GetTags(), metadata.GetTags())
+	}
+	if request.HasRuleSet() || request.HasSchemaTagsToAdd() {
+		t.Errorf("buildMigrationRegisterSchemaRequest() = %#v, expected no rule set and schema tags", request)
+	}
+}
+
+func TestCompareMigratedSchemas(t *testing.T) {
+	expected := schemaregistryv1.NewSchema()
+	expected.SetSubject("test-value")
+	expected.SetVersion(1)
+	expected.SetId(100001)
+	expected.SetSchema("{}")
+
+	if issues := compareMigratedSchemas(*expected, *expected); len(issues) > 0 {
+		t.Errorf("compareMigratedSchemas() = %v, expected no issues", issues)
+	}
+
+	actual := *expected
+	actual.SetId(100002)
+	actual.SetSchemaType("JSON")
+	actual.SetSchema(`{"type": "object"}`)
+	expectedIssues := []string{
+		`version 1 of Subject "test-value": expected ID 100001, got 100002`,
+		`version 1 of Subject "test-value": expected schema type "", got "JSON"`,
+		`version 1 of Subject "test-value": the schema definitions are different`,
+	}
+	if issues := compareMigratedSchemas(*expected, actual); !reflect.DeepEqual(issues, expectedIssues) {
+		t.Errorf("compareMigratedSchemas() = %#v, expected %#v", issues, expectedIssues)
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2026 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+const (
+	ordersSchemaResourceLabel = "confluent_schema.orders"
+	ordersSchemaSubjectPath   = "/subjects/orders-value"
+	ordersSchemaModePath      = "/mode/orders-value"
+	// Version 3 of orders-value, as Schema Registry lists it once it has been registered
+	ordersSchemasResponse = `[{"subject": "orders-value", "version": 3, "id": 100007, "schema": "{\"fields\":[{\"name\":\"id\",\"type\":\"string\"}],\"name\":\"Order\",\"type\":\"record\"}"}]`
+)
+
+func TestAccSchemaRestoreSoftDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	stubSoftDeletedOrdersSchema(wiremockClient)
+	// The subject has a subject-level mode, which is updated back after the restore
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(ordersSchemaModePath)).
+		WithQueryParam("defaultToGlobal", wiremock.EqualTo("false")).
+		WillReturn(`{"mode": "READWRITE"}`, contentTypeJSONHeader, http.StatusOK))
+	importModeStub := wiremock.Put(wiremock.URLPathEqualTo(ordersSchemaModePath)).
+		WithBodyPattern(wiremock.Contains(`"mode":"IMPORT"`)).
+		WillReturn(`{"mode": "IMPORT"}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(importModeStub)
+	previousModeStub := wiremock.Put(wiremock.URLPathEqualTo(ordersSchemaModePath)).
+		WithBodyPattern(wiremock.Contains(`"mode":"READWRITE"`)).
+		WillReturn(`{"mode": "READWRITE"}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(previousModeStub)
+	deleteModeStub := wiremock.Delete(wiremock.URLPathEqualTo(ordersSchemaModePath)).
+		WillReturn(`{"mode": "READWRITE"}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(deleteModeStub)
+
+	restoreSchemaStub := wiremock.Post(wiremock.URLPathEqualTo(ordersSchemaSubjectPath+"/versions")).
+		WithBodyPattern(wiremock.Contains(`"version":3`)).
+		WithBodyPattern(wiremock.Contains(`"id":100007`)).
+		WillReturn(`{"id": 100007}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(restoreSchemaStub)
+
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo("/schemas")).
+		WithQueryParam("subjectPrefix", wiremock.EqualTo("orders-value")).
+		WillReturn(ordersSchemasResponse, contentTypeJSONHeader, http.StatusOK))
+	deleteSchemaStub := wiremock.Delete(wiremock.URLPathEqualTo(ordersSchemaSubjectPath+"/versions/3")).
+		WillReturn("3", contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(deleteSchemaStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckOrdersSchemaConfig(mockServerUrl, "orders-value", false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(ordersSchemaResourceLabel, "id", fmt.Sprintf("%s/orders-value/100007", testStreamGovernanceClusterId)),
+					resource.TestCheckResourceAttr(ordersSchemaResourceLabel, "subject_name", "orders-value"),
+					resource.TestCheckResourceAttr(ordersSchemaResourceLabel, "version", "3"),
+					resource.TestCheckResourceAttr(ordersSchemaResourceLabel, "schema_identifier", "100007"),
+					resource.TestCheckResourceAttr(ordersSchemaResourceLabel, "restore_soft_deleted", "true"),
+					resource.TestCheckResourceAttr(ordersSchemaResourceLabel, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+
+	// The soft deleted version is registered again with its original version and ID in IMPORT mode
+	checkStubCount(t, wiremockClient, importModeStub, fmt.Sprintf("PUT %s", ordersSchemaModePath), expectedCountOne)
+	checkStubCount(t, wiremockClient, restoreSchemaStub, fmt.Sprintf("POST %s/versions", ordersSchemaSubjectPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, previousModeStub, fmt.Sprintf("PUT %s", ordersSchemaModePath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteModeStub, fmt.Sprintf("DELETE %s", ordersSchemaModePath), expectedCountZero)
+	checkStubCount(t, wiremockClient, deleteSchemaStub, fmt.Sprintf("DELETE %s/versions/3", ordersSchemaSubjectPath), expectedCountOne)
+}
+
+func TestAccSchemaRestoreSoftDeletedRestoresModeOnFailure(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	stubSoftDeletedOrdersSchema(wiremockClient)
+	// The subject doesn't have a subject-level mode, so it's deleted after the restore
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(ordersSchemaModePath)).
+		WithQueryParam("defaultToGlobal", wiremock.EqualTo("false")).
+		WillReturn(`{"error_code": 40409, "message": "Subject 'orders-value' does not have subject-level mode configured"}`, contentTypeJSONHeader, http.StatusNotFound))
+	importModeStub := wiremock.Put(wiremock.URLPathEqualTo(ordersSchemaModePath)).
+		WithBodyPattern(wiremock.Contains(`"mode":"IMPORT"`)).
+		WillReturn(`{"mode": "IMPORT"}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(importModeStub)
+	deleteModeStub := wiremock.Delete(wiremock.URLPathEqualTo(ordersSchemaModePath)).
+		WillReturn(`{"mode": "IMPORT"}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(deleteModeStub)
+	restoreSchemaStub := wiremock.Post(wiremock.URLPathEqualTo(ordersSchemaSubjectPath+"/versions")).
+		WillReturn(`{"error_code": 42207, "message": "Overwrite new schema with id 100007 is not permitted."}`, contentTypeJSONHeader, http.StatusUnprocessableEntity)
+	_ = wiremockClient.StubFor(restoreSchemaStub)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckOrdersSchemaConfig(mockServerUrl, "orders-value", false, true),
+				ExpectError: regexp.MustCompile("error restoring soft deleted Schema: error registering version 3"),
+			},
+		},
+	})
+
+	// The subject doesn't stay in IMPORT mode
+	checkStubCount(t, wiremockClient, importModeStub, fmt.Sprintf("PUT %s", ordersSchemaModePath), expectedCountOne)
+	checkStubCount(t, wiremockClient, restoreSchemaStub, fmt.Sprintf("POST %s/versions", ordersSchemaSubjectPath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteModeStub, fmt.Sprintf("DELETE %s", ordersSchemaModePath), expectedCountOne)
+}
+
+// stubSoftDeletedOrdersSchema stubs the compatibility check and the lookups of a schema whose only version is soft deleted.
+func stubSoftDeletedOrdersSchema(wiremockClient *wiremock.Client) {
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo("/compatibility"+ordersSchemaSubjectPath+"/versions")).
+		WillReturn(`{"is_compatible": true}`, contentTypeJSONHeader, http.StatusOK))
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(ordersSchemaSubjectPath)).
+		WillReturn(`{"error_code": 40403, "message": "Schema not found"}`, contentTypeJSONHeader, http.StatusNotFound))
+	_ = wiremockClient.StubFor(wiremock.Post(wiremock.URLPathEqualTo(ordersSchemaSubjectPath)).
+		WithQueryParam("deleted", wiremock.EqualTo("true")).
+		WillReturn(`{"subject": "orders-value", "version": 3, "id": 100007, "schema": "{}"}`, contentTypeJSONHeader, http.StatusOK))
+}
+
+func testAccCheckOrdersSchemaConfig(mockServerUrl, subjectName string, deletionProtection, restoreSoftDeleted bool) string {
+	return fmt.Sprintf(`
+	resource "confluent_schema" "orders" {
+	  schema_registry_cluster {
+	    id = "%s"
+	  }
+	  rest_endpoint = "%s"
+	  credentials {
+	    key    = "%s"
+	    secret = "%s"
+	  }
+
+	  subject_name = "%s"
+	  format       = "AVRO"
+	  schema       = jsonencode({type = "record", name = "Order", fields = [{name = "id", type = "string"}]})
+
+	  validate_locally            = true
+	  skip_validation_during_plan = true
+	  deletion_protection         = %t
+	  restore_soft_deleted        = %t
+	}
+	`, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret, subjectName, deletionProtection, restoreSoftDeleted)
+}
@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -53,12 +52,14 @@ func subjectConfigResource() *schema.Resource {
 			},
 			paramCredentials: credentialsSchema(),
 			paramSubjectName: {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				Description:  "The name of the Schema Registry Subject.",
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The name of the Schema Registry Subject.",
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: subjectNameDiffSuppressFunc,
 			},
+			paramContext: subjectContextSchema(),
 			paramCompatibilityLevel: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -82,8 +83,14 @@ func subjectConfigResource() *schema.Resource {
 				Computed:    true,
 				Description: "The subject name that this subject is an alias for. Any reference to this subject will be replaced by the alias.",
 			},
+			paramDeletionProtection: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     paramDeletionProtectionDefaultValue,
+				Description: "Controls whether a subject config can be deleted or recreated.",
+			},
 		},
-		CustomizeDiff: customdiff.Sequence(resourceCredentialBlockValidationWithOAuth),
+		CustomizeDiff: customdiff.Sequence(resourceCredentialBlockValidationWithOAuth, schemaRegistryDeletionProtectionCustomizeDiff("Subject Config", paramSchemaRegistryCluster, paramRestEndpoint, paramSubjectName, paramContext)),
 	}
 }
 
@@ -101,7 +108,7 @@ func subjectConfigCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("error creating Subject Config: %s", createDescriptiveError(err))
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	subjectName := d.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 
 	createConfigRequest := schemaregistryv1.NewConfigUpdateRequest()
 	hasConfigToUpdate := false
@@ -159,7 +166,11 @@ func subjectConfigDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return diag.Errorf("error deleting Subject Config: %s", createDescriptiveError(err))
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	subjectName := d.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
+
+	if d.Get(paramDeletionProtection).(bool) {
+		return diag.Errorf("error deleting Subject Config %q: %s is enabled. Set %s = false and run `terraform apply` before deleting or recreating the subject config", d.Id(), paramDeletionProtection, paramDeletionProtection)
+	}
 
 	// Deletes the subject-level setting and reverts to the cluster-wide default.
 	_, resp, err := schemaRegistryRestClient.apiClient.ConfigV1Api.DeleteSubjectConfig(schemaRegistryRestClient.apiContext(ctx), subjectName).Execute()
@@ -190,12 +201,15 @@ func subjectConfigRead(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("error reading Subject Config: %s", createDescriptiveError(err))
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	subjectName := d.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 
 	_, err = readSubjectConfigAndSetAttributes(ctx, d, schemaRegistryRestClient, subjectName)
 	if err != nil {
 		return diag.Errorf("error reading Subject Config: %s", createDescriptiveError(err))
 	}
+	if err := setSubjectConfigDefaultAttributes(d); err != nil {
+		return diag.Errorf("error reading Subject Config: %s", createDescriptiveError(err))
+	}
 
 	tflog.Debug(ctx, fmt.Sprintf("Finished reading Subject Config %q", d.Id()), map[string]interface{}{subjectConfigLoggingKey: d.Id()})
 
@@ -218,15 +232,11 @@ func subjectConfigImport(ctx context.Context, d *schema.ResourceData, meta inter
 		return nil, fmt.Errorf("error importing Subject Config: %s", createDescriptiveError(err))
 	}
 
-	clusterIDAndSubjectName := d.Id()
-	parts := strings.Split(clusterIDAndSubjectName, "/")
-	if len(parts) < 2 {
-		return nil, fmt.Errorf("error importing Subject Config: invalid format: expected '<SR cluster ID>/<subject name>' where subject name may contain forward slashes")
+	clusterId, subjectName, err := extractClusterIdAndSubjectNameFromTfId(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error importing Subject Config: %s", err.Error())
 	}
 
-	clusterId := parts[0]
-	subjectName := strings.Join(parts[1:], "/")
-
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
 
 	// Mark resource as new to avoid d.Set("") when getting 404
@@ -234,6 +244,9 @@ func subjectConfigImport(ctx context.Context, d *schema.ResourceData, meta inter
 	if _, err := readSubjectConfigAndSetAttributes(ctx, d, schemaRegistryRestClient, subjectName); err != nil {
 		return nil, fmt.Errorf("error importing Subject Config %q: %s", d.Id(), createDescriptiveError(err))
 	}
+	if err := setSubjectConfigDefaultAttributes(d); err != nil {
+		return nil, fmt.Errorf("error importing Subject Config %q: %s", d.Id(), createDescriptiveError(err))
+	}
 	tflog.Debug(ctx, fmt.Sprintf("Finished importing Subject Config %q", d.Id()), map[string]interface{}{subjectConfigLoggingKey: d.Id()})
 	return []*schema.ResourceData{d}, nil
 }
@@ -261,7 +274,7 @@ func readSubjectConfigAndSetAttributes(ctx context.Context, d *schema.ResourceDa
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Fetched Subject Config %q: %s", d.Id(), subjectConfigJson), map[string]interface{}{subjectConfigLoggingKey: d.Id()})
 
-	if err := d.Set(paramSubjectName, subjectName); err != nil {
+	if err := setSubjectNameAndContext(d, subjectName); err != nil {
 		return nil, err
 	}
 
@@ -298,9 +311,19 @@ func readSubjectConfigAndSetAttributes(ctx context.Context, d *schema.ResourceDa
 	return []*schema.ResourceData{d}, nil
 }
 
+// setSubjectConfigDefaultAttributes sets the attributes that only the resource has, which is why
+// they aren't set by readSubjectConfigAndSetAttributes that the data source shares.
+func setSubjectConfigDefaultAttributes(d *schema.ResourceData) error {
+	// Explicitly set paramDeletionProtection to the default value if unset
+	if _, ok := d.GetOk(paramDeletionProtection); !ok && d.Id() != "" {
+		return d.Set(paramDeletionProtection, paramDeletionProtectionDefaultValue)
+	}
+	return nil
+}
+
 func subjectConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	if d.HasChangesExcept(paramCredentials, paramCompatibilityLevel, paramCompatibilityGroup, paramNormalize, paramAlias) {
-		return diag.Errorf("error updating Subject Config %q: only %q, %q, %q, %q and %q blocks can be updated for Subject Config", d.Id(), paramCredentials, paramCompatibilityLevel, paramCompatibilityGroup, paramNormalize, paramAlias)
+	if d.HasChangesExcept(paramCredentials, paramCompatibilityLevel, paramCompatibilityGroup, paramNormalize, paramAlias, paramDeletionProtection) {
+		return diag.Errorf("error updating Subject Config %q: only %q, %q, %q, %q, %q and %q blocks can be updated for Subject Config", d.Id(), paramCredentials, paramCompatibilityLevel, paramCompatibilityGroup, paramNormalize, paramAlias, paramDeletionProtection)
 	}
 	if d.HasChange(paramCompatibilityLevel) || d.HasChange(paramCompatibilityGroup) || d.HasChange(paramNormalize) || d.HasChange(paramAlias) {
 		updateConfigRequest := schemaregistryv1.NewConfigUpdateRequest()
@@ -329,7 +352,7 @@ func subjectConfigUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			return diag.Errorf("error updating Subject Config: %s", createDescriptiveError(err))
 		}
 		schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-		subjectName := d.Get(paramSubjectName).(string)
+		subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 		updateConfigRequestJson, err := json.Marshal(updateConfigRequest)
 		if err != nil {
 			return diag.Errorf("error updating Subject Config: error marshaling %#v to json: %s", updateConfigRequest, createDescriptiveError(err))
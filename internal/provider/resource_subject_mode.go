@@ -22,7 +22,6 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -53,12 +52,14 @@ func subjectModeResource() *schema.Resource {
 			},
 			paramCredentials: credentialsSchema(),
 			paramSubjectName: {
-				Type:         schema.TypeString,
-				Required:     true,
-				ForceNew:     true,
-				Description:  "The name of the Schema Registry Subject.",
-				ValidateFunc: validation.StringIsNotEmpty,
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "The name of the Schema Registry Subject.",
+				ValidateFunc:     validation.StringIsNotEmpty,
+				DiffSuppressFunc: subjectNameDiffSuppressFunc,
 			},
+			paramContext: subjectContextSchema(),
 			paramMode: {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -90,7 +91,7 @@ func subjectModeCreate(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("error creating Subject Mode: %s", createDescriptiveError(err))
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	subjectName := d.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 
 	createModeRequest := schemaregistryv1.NewModeUpdateRequest()
 	hasModeToUpdate := false
@@ -140,7 +141,7 @@ func subjectModeDelete(ctx context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf("error deleting Subject Mode: %s", createDescriptiveError(err))
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	subjectName := d.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 
 	// Deletes the subject-level setting and reverts to the cluster-wide default.
 	_, resp, err := schemaRegistryRestClient.apiClient.ModesV1Api.DeleteSubjectMode(schemaRegistryRestClient.apiContext(ctx), subjectName).Execute()
@@ -171,7 +172,7 @@ func subjectModeRead(ctx context.Context, d *schema.ResourceData, meta interface
 		return diag.Errorf("error reading Subject Mode: %s", createDescriptiveError(err))
 	}
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-	subjectName := d.Get(paramSubjectName).(string)
+	subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 
 	_, err = readSubjectModeAndSetAttributes(ctx, d, schemaRegistryRestClient, subjectName)
 	if err != nil {
@@ -199,15 +200,11 @@ func subjectModeImport(ctx context.Context, d *schema.ResourceData, meta interfa
 		return nil, fmt.Errorf("error importing Subject Mode: %s", createDescriptiveError(err))
 	}
 
-	clusterIDAndSubjectName := d.Id()
-	parts := strings.Split(clusterIDAndSubjectName, "/")
-	if len(parts) < 2 {
-		return nil, fmt.Errorf("error importing Subject Mode: invalid format: expected '<SR cluster ID>/<subject name>' where subject name may contain forward slashes")
+	clusterId, subjectName, err := extractClusterIdAndSubjectNameFromTfId(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error importing Subject Mode: %s", err.Error())
 	}
 
-	clusterId := parts[0]
-	subjectName := strings.Join(parts[1:], "/")
-
 	schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
 
 	// Mark resource as new to avoid d.Set("") when getting 404
@@ -239,7 +236,7 @@ func readSubjectModeAndSetAttributes(ctx context.Context, d *schema.ResourceData
 	}
 	tflog.Debug(ctx, fmt.Sprintf("Fetched Subject Mode %q: %s", d.Id(), subjectModeJson), map[string]interface{}{subjectModeLoggingKey: d.Id()})
 
-	if err := d.Set(paramSubjectName, subjectName); err != nil {
+	if err := setSubjectNameAndContext(d, subjectName); err != nil {
 		return nil, err
 	}
 
@@ -293,7 +290,7 @@ func subjectModeUpdate(ctx context.Context, d *schema.ResourceData, meta interfa
 			return diag.Errorf("error updating Subject Mode: %s", createDescriptiveError(err))
 		}
 		schemaRegistryRestClient := meta.(*Client).schemaRegistryRestClientFactory.CreateSchemaRegistryRestClient(restEndpoint, clusterId, clusterApiKey, clusterApiSecret, meta.(*Client).isSchemaRegistryMetadataSet, meta.(*Client).oauthToken)
-		subjectName := d.Get(paramSubjectName).(string)
+		subjectName := qualifySubjectName(d.Get(paramContext).(string), d.Get(paramSubjectName).(string))
 		updateModeRequestJson, err := json.Marshal(updateModeRequest)
 		if err != nil {
 			return diag.Errorf("error updating Subject Mode: error marshaling %#v to json: %s", updateModeRequest, createDescriptiveError(err))
@@ -0,0 +1,171 @@
+// Copyright 2026 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/walkerus/go-wiremock"
+)
+
+const (
+	teamASubjectModeResourceLabel = "confluent_subject_mode.team_a"
+	// Schema Registry addresses subjects in a context by their qualified names
+	teamASubjectModePath = "/mode/:.team-a:orders-value"
+)
+
+func TestAccSubjectModeWithContext(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createSubjectModeStub, deleteSubjectModeStub := stubTeamASubjectMode(wiremockClient)
+
+	// Set fake values for secrets since those are required for importing
+	_ = os.Setenv("IMPORT_SCHEMA_REGISTRY_API_KEY", testSchemaRegistryKey)
+	_ = os.Setenv("IMPORT_SCHEMA_REGISTRY_API_SECRET", testSchemaRegistrySecret)
+	_ = os.Setenv("IMPORT_SCHEMA_REGISTRY_REST_ENDPOINT", mockServerUrl)
+	defer func() {
+		_ = os.Unsetenv("IMPORT_SCHEMA_REGISTRY_API_KEY")
+		_ = os.Unsetenv("IMPORT_SCHEMA_REGISTRY_API_SECRET")
+		_ = os.Unsetenv("IMPORT_SCHEMA_REGISTRY_REST_ENDPOINT")
+	}()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckTeamASubjectModeConfig(mockServerUrl, "orders-value", ".team-a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(teamASubjectModeResourceLabel, "id", fmt.Sprintf("%s/:.team-a:orders-value", testStreamGovernanceClusterId)),
+					resource.TestCheckResourceAttr(teamASubjectModeResourceLabel, "subject_name", "orders-value"),
+					resource.TestCheckResourceAttr(teamASubjectModeResourceLabel, "context", ".team-a"),
+					resource.TestCheckResourceAttr(teamASubjectModeResourceLabel, "mode", "READONLY"),
+				),
+			},
+			{
+				// The import ID contains the qualified subject name, which is split into subject_name and context again
+				ResourceName:      teamASubjectModeResourceLabel,
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/:.team-a:orders-value", testStreamGovernanceClusterId),
+				ImportStateVerify: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createSubjectModeStub, fmt.Sprintf("PUT %s", teamASubjectModePath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteSubjectModeStub, fmt.Sprintf("DELETE %s", teamASubjectModePath), expectedCountOne)
+}
+
+func TestAccSubjectModeContextMovedFromSubjectName(t *testing.T) {
+	ctx := context.Background()
+
+	wiremockContainer, err := setupWiremock(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer wiremockContainer.Terminate(ctx)
+
+	mockServerUrl := wiremockContainer.URI
+	wiremockClient := wiremock.NewClient(mockServerUrl)
+	// nolint:errcheck
+	defer wiremockClient.Reset()
+
+	// nolint:errcheck
+	defer wiremockClient.ResetAllScenarios()
+
+	createSubjectModeStub, deleteSubjectModeStub := stubTeamASubjectMode(wiremockClient)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The context is set in the subject name, which is how contexts were set before the context attribute was added
+				Config: testAccCheckTeamASubjectModeConfig(mockServerUrl, ":.team-a:orders-value", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(teamASubjectModeResourceLabel, "id", fmt.Sprintf("%s/:.team-a:orders-value", testStreamGovernanceClusterId)),
+					resource.TestCheckResourceAttr(teamASubjectModeResourceLabel, "subject_name", ":.team-a:orders-value"),
+					resource.TestCheckResourceAttr(teamASubjectModeResourceLabel, "context", ".team-a"),
+				),
+			},
+			{
+				// Moving the context to the context attribute plans neither a replacement nor an update
+				Config:   testAccCheckTeamASubjectModeConfig(mockServerUrl, "orders-value", ".team-a"),
+				PlanOnly: true,
+			},
+		},
+	})
+
+	checkStubCount(t, wiremockClient, createSubjectModeStub, fmt.Sprintf("PUT %s", teamASubjectModePath), expectedCountOne)
+	checkStubCount(t, wiremockClient, deleteSubjectModeStub, fmt.Sprintf("DELETE %s", teamASubjectModePath), expectedCountOne)
+}
+
+// stubTeamASubjectMode stubs the READONLY mode of orders-value in the .team-a context and returns the stubs
+// that update and delete it.
+func stubTeamASubjectMode(wiremockClient *wiremock.Client) (*wiremock.StubRule, *wiremock.StubRule) {
+	createSubjectModeStub := wiremock.Put(wiremock.URLPathEqualTo(teamASubjectModePath)).
+		WithBodyPattern(wiremock.Contains(`"mode":"READONLY"`)).
+		WillReturn(`{"mode": "READONLY"}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(createSubjectModeStub)
+	_ = wiremockClient.StubFor(wiremock.Get(wiremock.URLPathEqualTo(teamASubjectModePath)).
+		WithQueryParam("defaultToGlobal", wiremock.EqualTo("true")).
+		WillReturn(`{"mode": "READONLY"}`, contentTypeJSONHeader, http.StatusOK))
+	deleteSubjectModeStub := wiremock.Delete(wiremock.URLPathEqualTo(teamASubjectModePath)).
+		WillReturn(`{"mode": "READONLY"}`, contentTypeJSONHeader, http.StatusOK)
+	_ = wiremockClient.StubFor(deleteSubjectModeStub)
+	return createSubjectModeStub, deleteSubjectModeStub
+}
+
+func testAccCheckTeamASubjectModeConfig(mockServerUrl, subjectName, subjectContext string) string {
+	contextAttribute := ""
+	if subjectContext != "" {
+		contextAttribute = fmt.Sprintf("context      = %q", subjectContext)
+	}
+	return fmt.Sprintf(`
+	resource "confluent_subject_mode" "team_a" {
+	  schema_registry_cluster {
+	    id = "%s"
+	  }
+	  rest_endpoint = "%s"
+	  credentials {
+	    key    = "%s"
+	    secret = "%s"
+	  }
+
+	  subject_name = "%s"
+	  %s
+	  mode         = "READONLY"
+	}
+	`, testStreamGovernanceClusterId, mockServerUrl, testSchemaRegistryKey, testSchemaRegistrySecret, subjectName, contextAttribute)
+}
@@ -128,6 +128,7 @@ func TestAccSubjectMode(t *testing.T) {
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "schema_registry_cluster.0.%", "1"),
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "schema_registry_cluster.0.id", testStreamGovernanceClusterId),
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "subject_name", testSubjectName),
+					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "context", ""),
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "mode", testSubjectMode),
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "rest_endpoint", mockSubjectModeTestServerUrl),
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "credentials.#", "1"),
@@ -146,6 +147,7 @@ func TestAccSubjectMode(t *testing.T) {
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "schema_registry_cluster.0.%", "1"),
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "schema_registry_cluster.0.id", testStreamGovernanceClusterId),
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "subject_name", testSubjectName),
+					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "context", ""),
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "mode", testUpdatedSubjectMode),
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "rest_endpoint", mockSubjectModeTestServerUrl),
 					resource.TestCheckResourceAttr(fullSubjectModeResourceLabel, "credentials.#", "1"),
@@ -0,0 +1,354 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/protocompile/ast"
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/bufbuild/protocompile/reporter"
+	"github.com/samber/lo"
+)
+
+const (
+	recordNameStrategy = "RecordNameStrategy"
+	fileNameStrategy   = "FileNameStrategy"
+)
+
+var acceptedSchemaBundleSubjectNameStrategies = []string{recordNameStrategy, fileNameStrategy}
+
+var schemaBundleFormatsByExtension = map[string]string{
+	".avsc":  avroFormat,
+	".json":  jsonFormat,
+	".proto": protobufFormat,
+}
+
+var avroPrimitiveTypes = []string{"null", "boolean", "int", "long", "float", "double", "bytes", "string"}
+
+// schemaBundleFile is a schema file of a bundle. Its path is relative to the base directory of the bundle
+// and uses forward slashes, so that it matches Protobuf imports and relative JSON Schema $ref values.
+type schemaBundleFile struct {
+	path       string
+	format     string
+	content    string
+	hash       string
+	recordName string
+	references []schemaBundleReference
+}
+
+// schemaBundleReference is a reference from one file of a bundle to another one.
+type schemaBundleReference struct {
+	name string
+	path string
+}
+
+// loadSchemaBundle reads the schema files matching a directory (walked recursively) or a glob pattern,
+// resolves the references between them and returns them in dependency order, that is, every file comes after the files it references.
+func loadSchemaBundle(bundlePath string) ([]schemaBundleFile, error) {
+	baseDirectory, filePaths, err := findSchemaBundleFiles(bundlePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(filePaths) == 0 {
+		return nil, fmt.Errorf("%q doesn't match any .avsc, .json or .proto files", bundlePath)
+	}
+
+	var files []schemaBundleFile
+	for _, filePath := range filePaths {
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %q: %s", filePath, err)
+		}
+		relativePath, err := filepath.Rel(baseDirectory, filePath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading %q: %s", filePath, err)
+		}
+		hash := sha256.Sum256(content)
+		files = append(files, schemaBundleFile{
+			path:    filepath.ToSlash(relativePath),
+			format:  schemaBundleFormatsByExtension[filepath.Ext(filePath)],
+			content: string(content),
+			hash:    hex.EncodeToString(hash[:]),
+		})
+	}
+
+	if err := resolveSchemaBundleReferences(files); err != nil {
+		return nil, err
+	}
+	return sortSchemaBundleFiles(files)
+}
+
+func findSchemaBundleFiles(bundlePath string) (string, []string, error) {
+	var filePaths []string
+	if info, err := os.Stat(bundlePath); err == nil && info.IsDir() {
+		err := filepath.WalkDir(bundlePath, func(filePath string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if _, ok := schemaBundleFormatsByExtension[filepath.Ext(filePath)]; ok && !entry.IsDir() {
+				filePaths = append(filePaths, filePath)
+			}
+			return nil
+		})
+		if err != nil {
+			return "", nil, fmt.Errorf("error reading directory %q: %s", bundlePath, err)
+		}
+		return bundlePath, filePaths, nil
+	}
+
+	matches, err := filepath.Glob(bundlePath)
+	if err != nil {
+		return "", nil, fmt.Errorf("error matching %q: %s", bundlePath, err)
+	}
+	for _, match := range matches {
+		if _, ok := schemaBundleFormatsByExtension[filepath.Ext(match)]; ok {
+			filePaths = append(filePaths, match)
+		}
+	}
+	// File paths are relative to the directory that the pattern starts with, for example, "schemas" for "schemas/*/*.proto"
+	baseDirectory := filepath.Dir(bundlePath)
+	for strings.ContainsAny(baseDirectory, "*?[\\") {
+		baseDirectory = filepath.Dir(baseDirectory)
+	}
+	return baseDirectory, filePaths, nil
+}
+
+func resolveSchemaBundleReferences(files []schemaBundleFile) error {
+	filesByPath := map[string]*schemaBundleFile{}
+	for i := range files {
+		filesByPath[files[i].path] = &files[i]
+	}
+	avroFilesByTypeName := map[string]*schemaBundleFile{}
+	avroUsedTypeNames := map[string][]string{}
+	jsonFilesById := map[string]*schemaBundleFile{}
+
+	for i := range files {
+		file := &files[i]
+		switch file.format {
+		case avroFormat:
+			var document interface{}
+			if err := json.Unmarshal([]byte(file.content), &document); err != nil {
+				return fmt.Errorf("error parsing %q: %s", file.path, err)
+			}
+			definedTypeNames := map[string]bool{}
+			var usedTypeNames []string
+			file.recordName = walkAvroTypeNames(document, "", definedTypeNames, &usedTypeNames)
+			for typeName := range definedTypeNames {
+				if otherFile, ok := avroFilesByTypeName[typeName]; ok {
+					return fmt.Errorf("named type %q is defined by both %q and %q", typeName, otherFile.path, file.path)
+				}
+				avroFilesByTypeName[typeName] = file
+			}
+			avroUsedTypeNames[file.path] = lo.Filter(lo.Uniq(usedTypeNames), func(typeName string, _ int) bool { return !definedTypeNames[typeName] })
+		case jsonFormat:
+			var document map[string]interface{}
+			if err := json.Unmarshal([]byte(file.content), &document); err != nil {
+				return fmt.Errorf("error parsing %q: %s", file.path, err)
+			}
+			if title, ok := document["title"].(string); ok && title != "" {
+				file.recordName = title
+			} else {
+				file.recordName = strings.TrimSuffix(path.Base(file.path), path.Ext(file.path))
+			}
+			if id, ok := document["$id"].(string); ok && id != "" {
+				jsonFilesById[id] = file
+			}
+		case protobufFormat:
+			fileNode, err := parser.Parse(file.path, strings.NewReader(file.content), reporter.NewHandler(nil))
+			if err != nil {
+				return fmt.Errorf("error parsing %q: %s", file.path, err)
+			}
+			var packageName string
+			for _, decl := range fileNode.Decls {
+				switch node := decl.(type) {
+				case *ast.PackageNode:
+					packageName = string(node.Name.AsIdentifier())
+				case *ast.MessageNode:
+					if file.recordName == "" {
+						file.recordName = node.Name.Val
+					}
+				case *ast.ImportNode:
+					importName := node.Name.AsString()
+					if _, ok := filesByPath[importName]; ok {
+						file.references = append(file.references, schemaBundleReference{name: importName, path: importName})
+					} else if !strings.HasPrefix(importName, "google/") && !strings.HasPrefix(importName, "confluent/") {
+						// Well-known Protobuf types and Confluent's own types are provided by Schema Registry
+						return fmt.Errorf("import %q of %q doesn't match any file of the bundle", importName, file.path)
+					}
+				}
+			}
+			if packageName != "" && file.recordName != "" {
+				file.recordName = packageName + "." + file.recordName
+			}
+		}
+	}
+
+	for i := range files {
+		file := &files[i]
+		switch file.format {
+		case avroFormat:
+			for _, typeName := range avroUsedTypeNames[file.path] {
+				referencedFile, ok := avroFilesByTypeName[typeName]
+				if !ok {
+					return fmt.Errorf("named type %q used by %q isn't defined by any file of the bundle", typeName, file.path)
+				}
+				// For AVRO, the reference name is the fully qualified name of the referenced type
+				file.references = append(file.references, schemaBundleReference{name: typeName, path: referencedFile.path})
+			}
+		case jsonFormat:
+			var document interface{}
+			_ = json.Unmarshal([]byte(file.content), &document)
+			var refs []string
+			walkJsonSchemaRefs(document, &refs)
+			for _, ref := range lo.Uniq(refs) {
+				referencedFile, ok := jsonFilesById[ref]
+				if !ok {
+					referencedFile, ok = filesByPath[path.Join(path.Dir(file.path), ref)]
+				}
+				if !ok {
+					return fmt.Errorf("$ref %q of %q doesn't match the $id or the path of any file of the bundle", ref, file.path)
+				}
+				if referencedFile != file {
+					file.references = append(file.references, schemaBundleReference{name: ref, path: referencedFile.path})
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// walkAvroTypeNames collects the fully qualified names of the named types that an AVRO schema defines and uses,
+// and returns the name of the top-level type, if it is a named type.
+func walkAvroTypeNames(node interface{}, namespace string, definedTypeNames map[string]bool, usedTypeNames *[]string) string {
+	switch typedNode := node.(type) {
+	case string:
+		if !lo.Contains(avroPrimitiveTypes, typedNode) {
+			*usedTypeNames = append(*usedTypeNames, avroFullName(typedNode, namespace))
+		}
+	case []interface{}:
+		for _, unionType := range typedNode {
+			walkAvroTypeNames(unionType, namespace, definedTypeNames, usedTypeNames)
+		}
+	case map[string]interface{}:
+		switch typedNode["type"] {
+		case "record", "error", "enum", "fixed":
+			name, _ := typedNode["name"].(string)
+			if typeNamespace, ok := typedNode["namespace"].(string); ok && !strings.Contains(name, ".") {
+				namespace = typeNamespace
+			}
+			fullName := avroFullName(name, namespace)
+			definedTypeNames[fullName] = true
+			// Named types inside a named type inherit its namespace
+			if index := strings.LastIndex(fullName, "."); index >= 0 {
+				namespace = fullName[:index]
+			} else {
+				namespace = ""
+			}
+			fields, _ := typedNode["fields"].([]interface{})
+			for _, field := range fields {
+				if fieldMap, ok := field.(map[string]interface{}); ok {
+					walkAvroTypeNames(fieldMap["type"], namespace, definedTypeNames, usedTypeNames)
+				}
+			}
+			return fullName
+		case "array":
+			walkAvroTypeNames(typedNode["items"], namespace, definedTypeNames, usedTypeNames)
+		case "map":
+			walkAvroTypeNames(typedNode["values"], namespace, definedTypeNames, usedTypeNames)
+		default:
+			walkAvroTypeNames(typedNode["type"], namespace, definedTypeNames, usedTypeNames)
+		}
+	}
+	return ""
+}
+
+func avroFullName(name, namespace string) string {
+	if strings.Contains(name, ".") || namespace == "" {
+		return name
+	}
+	return namespace + "." + name
+}
+
+// walkJsonSchemaRefs collects the $ref values of a JSON Schema that point to other documents, without their fragments.
+func walkJsonSchemaRefs(node interface{}, refs *[]string) {
+	switch typedNode := node.(type) {
+	case []interface{}:
+		for _, item := range typedNode {
+			walkJsonSchemaRefs(item, refs)
+		}
+	case map[string]interface{}:
+		for key, value := range typedNode {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				if ref = strings.SplitN(ref, "#", 2)[0]; ref != "" {
+					*refs = append(*refs, ref)
+				}
+				continue
+			}
+			walkJsonSchemaRefs(value, refs)
+		}
+	}
+}
+
+// sortSchemaBundleFiles sorts the files of a bundle topologically, breaking ties by path to keep the order stable.
+func sortSchemaBundleFiles(files []schemaBundleFile) ([]schemaBundleFile, error) {
+	sort.Slice(files, func(i, j int) bool { return files[i].path < files[j].path })
+	sorted := make([]schemaBundleFile, 0, len(files))
+	isSorted := map[string]bool{}
+	for len(sorted) < len(files) {
+		progress := false
+		for _, file := range files {
+			if isSorted[file.path] {
+				continue
+			}
+			if lo.EveryBy(file.references, func(reference schemaBundleReference) bool { return isSorted[reference.path] }) {
+				sorted = append(sorted, file)
+				isSorted[file.path] = true
+				progress = true
+			}
+		}
+		if !progress {
+			var cyclicPaths []string
+			for _, file := range files {
+				if !isSorted[file.path] {
+					cyclicPaths = append(cyclicPaths, file.path)
+				}
+			}
+			return nil, fmt.Errorf("files %s reference each other in a cycle", strings.Join(cyclicPaths, ", "))
+		}
+	}
+	return sorted, nil
+}
+
+// schemaBundleSubjectName returns the subject that a file of a bundle is registered under: the fully qualified name of its
+// top-level record (or message, or the title of a JSON Schema) for RecordNameStrategy, or its path for FileNameStrategy.
+func schemaBundleSubjectName(subjectNameStrategy string, file schemaBundleFile) (string, error) {
+	if subjectNameStrategy == fileNameStrategy {
+		return file.path, nil
+	}
+	if file.recordName == "" {
+		return "", fmt.Errorf("%q doesn't define a named type to derive a subject name from with %s", file.path, recordNameStrategy)
+	}
+	return file.recordName, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func writeSchemaBundleFiles(t *testing.T, files map[string]string) string {
+	directory := t.TempDir()
+	for filePath, content := range files {
+		fullPath := filepath.Join(directory, filepath.FromSlash(filePath))
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fullPath, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return directory
+}
+
+func TestLoadSchemaBundle(t *testing.T) {
+	tests := []struct {
+		name                 string
+		files                map[string]string
+		pattern              string
+		expectedOrder        []string
+		expectedReferences   map[string][]schemaBundleReference
+		expectedRecordNames  map[string]string
+		expectedErrorMessage string
+	}{
+		{
+			name: "PROTOBUF imports",
+			files: map[string]string{
+				"orders/order.proto":   `syntax = "proto3"; package shop.orders; import "common/address.proto"; import "google/protobuf/timestamp.proto"; message Order { shop.common.Address address = 1; google.protobuf.Timestamp created_at = 2; }`,
+				"common/address.proto": `syntax = "proto3"; package shop.common; import "common/country.proto"; message Address { shop.common.Country country = 1; }`,
+				"common/country.proto": `syntax = "proto3"; package shop.common; message Country { string code = 1; }`,
+				"README.md":            "not a schema",
+			},
+			expectedOrder: []string{"common/country.proto", "common/address.proto", "orders/order.proto"},
+			expectedReferences: map[string][]schemaBundleReference{
+				"common/address.proto": {{name: "common/country.proto", path: "common/country.proto"}},
+				"orders/order.proto":   {{name: "common/address.proto", path: "common/address.proto"}},
+			},
+			expectedRecordNames: map[string]string{"orders/order.proto": "shop.orders.Order", "common/country.proto": "shop.common.Country"},
+		},
+		{
+			name: "AVRO named types",
+			files: map[string]string{
+				"user.avsc":    `{"type": "record", "name": "User", "namespace": "io.confluent", "fields": [{"name": "address", "type": ["null", "Address"]}, {"name": "tags", "type": {"type": "array", "items": "string"}}]}`,
+				"address.avsc": `{"type": "record", "name": "io.confluent.Address", "fields": [{"name": "kind", "type": {"type": "enum", "name": "Kind", "symbols": ["HOME", "WORK"]}}]}`,
+			},
+			expectedOrder: []string{"address.avsc", "user.avsc"},
+			expectedReferences: map[string][]schemaBundleReference{
+				"user.avsc": {{name: "io.confluent.Address", path: "address.avsc"}},
+			},
+			expectedRecordNames: map[string]string{"user.avsc": "io.confluent.User", "address.avsc": "io.confluent.Address"},
+		},
+		{
+			name: "JSON Schema $ref values",
+			files: map[string]string{
+				"schemas/order.json":    `{"title": "Order", "type": "object", "properties": {"customer": {"$ref": "customer.json"}, "id": {"$ref": "#/definitions/id"}}, "definitions": {"id": {"type": "string"}}}`,
+				"schemas/customer.json": `{"$id": "https://example.com/customer.json", "type": "object"}`,
+			},
+			pattern:       "schemas/*.json",
+			expectedOrder: []string{"customer.json", "order.json"},
+			expectedReferences: map[string][]schemaBundleReference{
+				"order.json": {{name: "customer.json", path: "customer.json"}},
+			},
+			expectedRecordNames: map[string]string{"order.json": "Order", "customer.json": "customer"},
+		},
+		{
+			name: "import missing from the bundle",
+			files: map[string]string{
+				"order.proto": `syntax = "proto3"; import "address.proto"; message Order { Address address = 1; }`,
+			},
+			expectedErrorMessage: `import "address.proto" of "order.proto" doesn't match any file of the bundle`,
+		},
+		{
+			name: "AVRO named type missing from the bundle",
+			files: map[string]string{
+				"user.avsc": `{"type": "record", "name": "User", "fields": [{"name": "address", "type": "Address"}]}`,
+			},
+			expectedErrorMessage: `named type "Address" used by "user.avsc" isn't defined by any file of the bundle`,
+		},
+		{
+			name: "cycle",
+			files: map[string]string{
+				"a.proto": `syntax = "proto3"; import "b.proto"; message A { B b = 1; }`,
+				"b.proto": `syntax = "proto3"; import "a.proto"; message B { A a = 1; }`,
+			},
+			expectedErrorMessage: "files a.proto, b.proto reference each other in a cycle",
+		},
+		{
+			name:                 "no schema files",
+			files:                map[string]string{"README.md": "not a schema"},
+			expectedErrorMessage: "doesn't match any .avsc, .json or .proto files",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bundlePath := writeSchemaBundleFiles(t, tt.files)
+			if tt.pattern != "" {
+				bundlePath = filepath.Join(bundlePath, filepath.FromSlash(tt.pattern))
+			}
+			files, err := loadSchemaBundle(bundlePath)
+			if tt.expectedErrorMessage != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErrorMessage) {
+					t.Fatalf("loadSchemaBundle() error = %v, expected %q", err, tt.expectedErrorMessage)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("loadSchemaBundle() unexpected error: %v", err)
+			}
+			var order []string
+			for _, file := range files {
+				order = append(order, file.path)
+				if expectedReferences := tt.expectedReferences[file.path]; !reflect.DeepEqual(file.references, expectedReferences) {
+					t.Errorf("references of %q = %v, expected %v", file.path, file.references, expectedReferences)
+				}
+				if expectedRecordName, ok := tt.expectedRecordNames[file.path]; ok && file.recordName != expectedRecordName {
+					t.Errorf("record name of %q = %q, expected %q", file.path, file.recordName, expectedRecordName)
+				}
+			}
+			if !reflect.DeepEqual(order, tt.expectedOrder) {
+				t.Errorf("loadSchemaBundle() order = %v, expected %v", order, tt.expectedOrder)
+			}
+		})
+	}
+}
+
+func TestSchemaBundleSubjectName(t *testing.T) {
+	file := schemaBundleFile{path: "common/address.proto", recordName: "shop.common.Address"}
+	if subjectName, _ := schemaBundleSubjectName(recordNameStrategy, file); subjectName != "shop.common.Address" {
+		t.Errorf("schemaBundleSubjectName() = %q, expected %q", subjectName, "shop.common.Address")
+	}
+	if subjectName, _ := schemaBundleSubjectName(fileNameStrategy, file); subjectName != "common/address.proto" {
+		t.Errorf("schemaBundleSubjectName() = %q, expected %q", subjectName, "common/address.proto")
+	}
+	if _, err := schemaBundleSubjectName(recordNameStrategy, schemaBundleFile{path: "empty.proto"}); err == nil {
+		t.Error("schemaBundleSubjectName() expected an error for a file without a named type")
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/bufbuild/protocompile/reporter"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// schemaDiffSuppressFunc suppresses differences between schema definitions that Schema Registry considers to be the same schema,
+// such as the formatting differences between a schema file and the definition that Schema Registry returns.
+func schemaDiffSuppressFunc(_, oldSchema, newSchema string, d *schema.ResourceData) bool {
+	if oldSchema == "" || newSchema == "" {
+		return false
+	}
+	return areSchemasEquivalent(d.Get(paramFormat).(string), oldSchema, newSchema, extractSchemaReferenceNames(d.Get(paramSchemaReference)), false)
+}
+
+// areSchemasEquivalent compares the canonical forms of two schema definitions. With normalize, it also ignores
+// the differences that Schema Registry ignores for subjects with normalization enabled. Definitions that can't be parsed
+// locally are never considered equivalent unless they're identical.
+func areSchemasEquivalent(format, oldSchema, newSchema string, referenceNames []string, normalize bool) bool {
+	if oldSchema == newSchema {
+		return true
+	}
+	switch format {
+	case avroFormat:
+		oldCanonicalSchema, err := canonicalAvroSchema(oldSchema, referenceNames)
+		if err != nil {
+			return false
+		}
+		newCanonicalSchema, err := canonicalAvroSchema(newSchema, referenceNames)
+		return err == nil && oldCanonicalSchema == newCanonicalSchema
+	case jsonFormat:
+		oldCanonicalSchema, err := canonicalJsonSchema(oldSchema, normalize)
+		if err != nil {
+			return false
+		}
+		newCanonicalSchema, err := canonicalJsonSchema(newSchema, normalize)
+		return err == nil && oldCanonicalSchema == newCanonicalSchema
+	case protobufFormat:
+		oldDescriptor, err := protobufSchemaDescriptor(oldSchema, normalize)
+		if err != nil {
+			return false
+		}
+		newDescriptor, err := protobufSchemaDescriptor(newSchema, normalize)
+		return err == nil && proto.Equal(oldDescriptor, newDescriptor)
+	}
+	return false
+}
+
+// canonicalAvroSchema follows the transformations of the Avro Parsing Canonical Form that don't change the schema
+// (fully qualified names, primitive types without their JSON objects, a fixed order of attributes and no whitespace),
+// but keeps docs, aliases and defaults, since Schema Registry registers a new version when any of them changes.
+func canonicalAvroSchema(schemaContent string, referenceNames []string) (string, error) {
+	avroSchema, err := parseAvroSchemaLocally(schemaContent, referenceNames)
+	if err != nil {
+		return "", err
+	}
+	canonicalSchema, err := json.Marshal(avroSchema)
+	if err != nil {
+		return "", err
+	}
+	return string(canonicalSchema), nil
+}
+
+// canonicalJsonSchema sorts the keys of a JSON Schema and removes whitespace. With normalize,
+// it also sorts the "required" arrays, whose order doesn't affect validation.
+func canonicalJsonSchema(schemaContent string, normalize bool) (string, error) {
+	decoder := json.NewDecoder(strings.NewReader(schemaContent))
+	// Numbers are kept as written, for example, 1 and 1.0 differ
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return "", err
+	}
+	if normalize {
+		sortJsonSchemaRequiredProperties(document)
+	}
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(document); err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+func sortJsonSchemaRequiredProperties(node interface{}) {
+	switch typedNode := node.(type) {
+	case []interface{}:
+		for _, item := range typedNode {
+			sortJsonSchemaRequiredProperties(item)
+		}
+	case map[string]interface{}:
+		for key, value := range typedNode {
+			if values, ok := value.([]interface{}); ok && key == "required" {
+				if required := jsonStringList(values); len(required) == len(values) {
+					sort.Strings(required)
+					typedNode[key] = required
+					continue
+				}
+			}
+			sortJsonSchemaRequiredProperties(value)
+		}
+	}
+}
+
+// protobufSchemaDescriptor builds the descriptor of a Protobuf schema without resolving its imports, which aren't available
+// offline. With normalize, imports are sorted, since Schema Registry sorts them for subjects with normalization enabled.
+func protobufSchemaDescriptor(schemaContent string, normalize bool) (*descriptorpb.FileDescriptorProto, error) {
+	handler := reporter.NewHandler(nil)
+	fileNode, err := parser.Parse(localProtobufSchemaFileName, strings.NewReader(schemaContent), handler)
+	if err != nil {
+		return nil, err
+	}
+	result, err := parser.ResultFromAST(fileNode, false, handler)
+	if err != nil {
+		return nil, err
+	}
+	descriptor := proto.Clone(result.FileDescriptorProto()).(*descriptorpb.FileDescriptorProto)
+	// Comments and positions aren't part of the schema
+	descriptor.SourceCodeInfo = nil
+	if normalize && len(descriptor.PublicDependency) == 0 && len(descriptor.WeakDependency) == 0 {
+		sort.Strings(descriptor.Dependency)
+	}
+	return descriptor, nil
+}
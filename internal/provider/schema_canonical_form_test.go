@@ -0,0 +1,134 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestAreSchemasEquivalent(t *testing.T) {
+	tests := []struct {
+		name           string
+		format         string
+		oldSchema      string
+		newSchema      string
+		referenceNames []string
+		normalize      bool
+		expected       bool
+	}{
+		{
+			name:      "AVRO whitespace and attribute order",
+			format:    avroFormat,
+			oldSchema: `{"type":"record","name":"User","namespace":"io.confluent","fields":[{"name":"age","type":"int"}]}`,
+			newSchema: "{\n  \"name\": \"User\",\n  \"namespace\": \"io.confluent\",\n  \"type\": \"record\",\n  \"fields\": [\n    {\"type\": {\"type\": \"int\"}, \"name\": \"age\"}\n  ]\n}",
+			expected:  true,
+		},
+		{
+			name:      "AVRO namespace written as part of the name",
+			format:    avroFormat,
+			oldSchema: `{"type": "record", "name": "io.confluent.User", "fields": []}`,
+			newSchema: `{"type": "record", "name": "User", "namespace": "io.confluent", "fields": []}`,
+			expected:  true,
+		},
+		{
+			name:      "AVRO default change",
+			format:    avroFormat,
+			oldSchema: `{"type": "record", "name": "User", "fields": [{"name": "age", "type": "int", "default": 0}]}`,
+			newSchema: `{"type": "record", "name": "User", "fields": [{"name": "age", "type": "int", "default": 1}]}`,
+			expected:  false,
+		},
+		{
+			name:           "AVRO named type from a reference",
+			format:         avroFormat,
+			oldSchema:      `{"type": "record", "name": "User", "fields": [{"name": "address", "type": "Address"}]}`,
+			newSchema:      `{"fields": [{"type": "Address", "name": "address"}], "name": "User", "type": "record"}`,
+			referenceNames: []string{"Address"},
+			expected:       true,
+		},
+		{
+			name:      "JSON key order and whitespace",
+			format:    jsonFormat,
+			oldSchema: `{"type":"object","properties":{"name":{"type":"string"}}}`,
+			newSchema: "{\n  \"properties\": {\"name\": {\"type\": \"string\"}},\n  \"type\": \"object\"\n}",
+			expected:  true,
+		},
+		{
+			name:      "JSON number literals",
+			format:    jsonFormat,
+			oldSchema: `{"type": "number", "maximum": 1}`,
+			newSchema: `{"type": "number", "maximum": 1.0}`,
+			expected:  false,
+		},
+		{
+			name:      "JSON required order without normalization",
+			format:    jsonFormat,
+			oldSchema: `{"type": "object", "required": ["a", "b"]}`,
+			newSchema: `{"type": "object", "required": ["b", "a"]}`,
+			expected:  false,
+		},
+		{
+			name:      "JSON required order with normalization",
+			format:    jsonFormat,
+			oldSchema: `{"type": "object", "required": ["a", "b"]}`,
+			newSchema: `{"type": "object", "required": ["b", "a"]}`,
+			normalize: true,
+			expected:  true,
+		},
+		{
+			name:      "PROTOBUF formatting and comments",
+			format:    protobufFormat,
+			oldSchema: "syntax = \"proto3\";\npackage shop;\n\nmessage Order {\n  string id = 1;\n}\n",
+			newSchema: "syntax = \"proto3\"; package shop; // orders\nmessage Order { string id = 1; }",
+			expected:  true,
+		},
+		{
+			name:      "PROTOBUF field number change",
+			format:    protobufFormat,
+			oldSchema: `syntax = "proto3"; message Order { string id = 1; }`,
+			newSchema: `syntax = "proto3"; message Order { string id = 2; }`,
+			expected:  false,
+		},
+		{
+			name:      "PROTOBUF import order without normalization",
+			format:    protobufFormat,
+			oldSchema: `syntax = "proto3"; import "a.proto"; import "b.proto"; message Order { A a = 1; B b = 2; }`,
+			newSchema: `syntax = "proto3"; import "b.proto"; import "a.proto"; message Order { A a = 1; B b = 2; }`,
+			expected:  false,
+		},
+		{
+			name:      "PROTOBUF import order with normalization",
+			format:    protobufFormat,
+			oldSchema: `syntax = "proto3"; import "a.proto"; import "b.proto"; message Order { A a = 1; B b = 2; }`,
+			newSchema: `syntax = "proto3"; import "b.proto"; import "a.proto"; message Order { A a = 1; B b = 2; }`,
+			normalize: true,
+			expected:  true,
+		},
+		{
+			name:      "unparsable definitions",
+			format:    avroFormat,
+			oldSchema: "foobar",
+			newSchema: "foobar ",
+			expected:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := areSchemasEquivalent(tt.format, tt.oldSchema, tt.newSchema, tt.referenceNames, tt.normalize); actual != tt.expected {
+				t.Errorf("areSchemasEquivalent() = %v, expected %v", actual, tt.expected)
+			}
+		})
+	}
+}
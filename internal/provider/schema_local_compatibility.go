@@ -0,0 +1,420 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hamba/avro/v2"
+	"github.com/samber/lo"
+)
+
+const (
+	newSchemaLabel      = "new"
+	previousSchemaLabel = "previous"
+	rootSchemaPath      = "(root)"
+)
+
+// schemaCompatibilityCheck collects incompatibilities between a reader schema and a writer schema,
+// that is, the reasons why data written with the writer schema can't be read with the reader schema.
+type schemaCompatibilityCheck struct {
+	reader string
+	writer string
+	issues []string
+}
+
+func (c *schemaCompatibilityCheck) addIssue(path, format string, args ...interface{}) {
+	if path == "" {
+		path = rootSchemaPath
+	}
+	c.issues = append(c.issues, fmt.Sprintf("%q: %s", path, fmt.Sprintf(format, args...)))
+}
+
+// checkSchemaCompatibilityLocally checks a new schema against the previous version of a subject for the given compatibility
+// level and returns the incompatibilities found. Only the previous version is available, so transitive levels are checked
+// the same way as their non-transitive counterparts. Formats other than AVRO and JSON aren't checked.
+func checkSchemaCompatibilityLocally(format, compatibilityLevel, previousSchema, newSchema string, previousReferenceNames, newReferenceNames []string) ([]string, error) {
+	var checkBackward, checkForward bool
+	switch compatibilityLevel {
+	case compatibilityLevelBackward, compatibilityLevelBackwardTransitive:
+		checkBackward = true
+	case compatibilityLevelForward, compatibilityLevelForwardTransitive:
+		checkForward = true
+	case compatibilityLevelFull, compatibilityLevelFullTransitive:
+		checkBackward = true
+		checkForward = true
+	default:
+		return nil, nil
+	}
+
+	var check func(reader, writer interface{}, c *schemaCompatibilityCheck)
+	var parsedPrevious, parsedNew interface{}
+	switch format {
+	case avroFormat:
+		previousAvroSchema, err := parseAvroSchemaLocally(previousSchema, previousReferenceNames)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing the previous schema: %s", err)
+		}
+		newAvroSchema, err := parseAvroSchemaLocally(newSchema, newReferenceNames)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing the new schema: %s", err)
+		}
+		parsedPrevious, parsedNew = previousAvroSchema, newAvroSchema
+		check = func(reader, writer interface{}, c *schemaCompatibilityCheck) {
+			c.checkAvro(reader.(avro.Schema), writer.(avro.Schema), "", map[string]bool{})
+		}
+	case jsonFormat:
+		if err := json.Unmarshal([]byte(previousSchema), &parsedPrevious); err != nil {
+			return nil, fmt.Errorf("error parsing the previous schema: %s", err)
+		}
+		if err := json.Unmarshal([]byte(newSchema), &parsedNew); err != nil {
+			return nil, fmt.Errorf("error parsing the new schema: %s", err)
+		}
+		check = func(reader, writer interface{}, c *schemaCompatibilityCheck) {
+			c.checkJson(reader, writer, "#")
+		}
+	default:
+		return nil, nil
+	}
+
+	var issues []string
+	if checkBackward {
+		// The new schema must be able to read data written with the previous one
+		c := &schemaCompatibilityCheck{reader: newSchemaLabel, writer: previousSchemaLabel}
+		check(parsedNew, parsedPrevious, c)
+		issues = append(issues, c.issues...)
+	}
+	if checkForward {
+		// The previous schema must be able to read data written with the new one
+		c := &schemaCompatibilityCheck{reader: previousSchemaLabel, writer: newSchemaLabel}
+		check(parsedPrevious, parsedNew, c)
+		issues = append(issues, c.issues...)
+	}
+	return lo.Uniq(issues), nil
+}
+
+func parseAvroSchemaLocally(schemaContent string, referenceNames []string) (avro.Schema, error) {
+	cache := &avro.SchemaCache{}
+	for _, referenceName := range referenceNames {
+		// Referenced types are identical in both versions as far as the provider can tell
+		_, _ = avro.ParseWithCache(fmt.Sprintf(`{"type": "record", "name": %q, "fields": []}`, referenceName), "", cache)
+	}
+	return avro.ParseWithCache(schemaContent, "", cache)
+}
+
+// checkAvro follows the schema resolution rules of the Avro specification.
+func (c *schemaCompatibilityCheck) checkAvro(reader, writer avro.Schema, path string, visited map[string]bool) {
+	if reader.Type() == avro.Ref {
+		reader = reader.(*avro.RefSchema).Schema()
+	}
+	if writer.Type() == avro.Ref {
+		writer = writer.(*avro.RefSchema).Schema()
+	}
+
+	if writer.Type() == avro.Union {
+		for _, writerBranch := range writer.(*avro.UnionSchema).Types() {
+			c.checkAvro(reader, writerBranch, path, visited)
+		}
+		return
+	}
+	if reader.Type() == avro.Union {
+		for _, readerBranch := range reader.(*avro.UnionSchema).Types() {
+			branchCheck := &schemaCompatibilityCheck{reader: c.reader, writer: c.writer}
+			branchCheck.checkAvro(readerBranch, writer, path, visited)
+			if len(branchCheck.issues) == 0 {
+				return
+			}
+		}
+		c.addIssue(path, "%s type %q doesn't match any type of the %s union", c.writer, avroTypeName(writer), c.reader)
+		return
+	}
+	if reader.Type() != writer.Type() {
+		if !isAvroTypePromotable(writer.Type(), reader.Type()) {
+			c.addIssue(path, "%s type %q can't be read as %s type %q", c.writer, avroTypeName(writer), c.reader, avroTypeName(reader))
+		}
+		return
+	}
+
+	switch reader.Type() {
+	case avro.Record:
+		readerRecord, writerRecord := reader.(*avro.RecordSchema), writer.(*avro.RecordSchema)
+		if !c.checkAvroName(readerRecord, writerRecord, path) {
+			return
+		}
+		// Recursive records are checked once per pair of record types
+		visitedKey := readerRecord.FullName() + "/" + writerRecord.FullName()
+		if visited[visitedKey] {
+			return
+		}
+		visited[visitedKey] = true
+		for _, readerField := range readerRecord.Fields() {
+			fieldPath := joinAvroFieldPath(path, readerField.Name())
+			writerField, found := lo.Find(writerRecord.Fields(), func(writerField *avro.Field) bool {
+				return writerField.Name() == readerField.Name() || lo.Contains(readerField.Aliases(), writerField.Name())
+			})
+			if !found {
+				if !readerField.HasDefault() {
+					c.addIssue(fieldPath, "field is missing in the %s schema and has no default value in the %s schema", c.writer, c.reader)
+				}
+				continue
+			}
+			c.checkAvro(readerField.Type(), writerField.Type(), fieldPath, visited)
+		}
+	case avro.Enum:
+		readerEnum, writerEnum := reader.(*avro.EnumSchema), writer.(*avro.EnumSchema)
+		if !c.checkAvroName(readerEnum, writerEnum, path) {
+			return
+		}
+		missingSymbols, _ := lo.Difference(writerEnum.Symbols(), readerEnum.Symbols())
+		if len(missingSymbols) > 0 && !readerEnum.HasDefault() {
+			c.addIssue(path, "symbols %s of the %s enum are missing in the %s enum, which has no default symbol", strings.Join(missingSymbols, ", "), c.writer, c.reader)
+		}
+	case avro.Fixed:
+		readerFixed, writerFixed := reader.(*avro.FixedSchema), writer.(*avro.FixedSchema)
+		if !c.checkAvroName(readerFixed, writerFixed, path) {
+			return
+		}
+		if readerFixed.Size() != writerFixed.Size() {
+			c.addIssue(path, "%s fixed size %d doesn't match %s fixed size %d", c.writer, writerFixed.Size(), c.reader, readerFixed.Size())
+		}
+	case avro.Array:
+		c.checkAvro(reader.(*avro.ArraySchema).Items(), writer.(*avro.ArraySchema).Items(), path+"[]", visited)
+	case avro.Map:
+		c.checkAvro(reader.(*avro.MapSchema).Values(), writer.(*avro.MapSchema).Values(), path+"{}", visited)
+	}
+}
+
+func (c *schemaCompatibilityCheck) checkAvroName(reader, writer avro.NamedSchema, path string) bool {
+	if reader.FullName() == writer.FullName() || lo.Contains(reader.Aliases(), writer.FullName()) {
+		return true
+	}
+	c.addIssue(path, "%s name %q doesn't match %s name %q or any of its aliases", c.writer, writer.FullName(), c.reader, reader.FullName())
+	return false
+}
+
+func isAvroTypePromotable(writerType, readerType avro.Type) bool {
+	switch writerType {
+	case avro.Int:
+		return readerType == avro.Long || readerType == avro.Float || readerType == avro.Double
+	case avro.Long:
+		return readerType == avro.Float || readerType == avro.Double
+	case avro.Float:
+		return readerType == avro.Double
+	case avro.String:
+		return readerType == avro.Bytes
+	case avro.Bytes:
+		return readerType == avro.String
+	}
+	return false
+}
+
+func avroTypeName(s avro.Schema) string {
+	if namedSchema, ok := s.(avro.NamedSchema); ok {
+		return namedSchema.FullName()
+	}
+	return string(s.Type())
+}
+
+func joinAvroFieldPath(path, fieldName string) string {
+	if path == "" {
+		return fieldName
+	}
+	return path + "." + fieldName
+}
+
+// checkJson checks that every document valid against the writer JSON Schema is valid against the reader one. Keywords
+// that the check doesn't cover, such as "$ref" and the "allOf", "anyOf" and "oneOf" combinations, are left to Schema Registry.
+func (c *schemaCompatibilityCheck) checkJson(reader, writer interface{}, path string) {
+	// The "false" schema accepts nothing, so nothing can be written with it
+	if writer == false {
+		return
+	}
+	if reader == false {
+		c.addIssue(path, "the %s schema rejects all values", c.reader)
+		return
+	}
+	readerSchema, writerSchema := jsonSchemaAsMap(reader), jsonSchemaAsMap(writer)
+	for _, keyword := range []string{"$ref", "allOf", "anyOf", "oneOf", "not"} {
+		if _, ok := readerSchema[keyword]; ok {
+			return
+		}
+		if _, ok := writerSchema[keyword]; ok {
+			return
+		}
+	}
+
+	readerTypes, writerTypes := jsonSchemaTypes(readerSchema), jsonSchemaTypes(writerSchema)
+	if readerTypes != nil {
+		if writerTypes == nil {
+			c.addIssue(path, "type %s was added in the %s schema", strings.Join(readerTypes, ", "), c.reader)
+		} else {
+			for _, writerType := range writerTypes {
+				// Every integer is a number too
+				if !lo.Contains(readerTypes, writerType) && !(writerType == "integer" && lo.Contains(readerTypes, "number")) {
+					c.addIssue(path, "%s type %q isn't allowed by %s type %s", c.writer, writerType, c.reader, strings.Join(readerTypes, ", "))
+				}
+			}
+		}
+	}
+
+	if readerEnum, ok := readerSchema["enum"].([]interface{}); ok {
+		writerEnum, ok := writerSchema["enum"].([]interface{})
+		if !ok {
+			c.addIssue(path, "enum was added in the %s schema", c.reader)
+		} else {
+			for _, value := range writerEnum {
+				if !lo.ContainsBy(readerEnum, func(readerValue interface{}) bool { return jsonValuesEqual(readerValue, value) }) {
+					c.addIssue(path, "%s enum value %s is missing in the %s enum", c.writer, jsonValueString(value), c.reader)
+				}
+			}
+		}
+	}
+
+	c.checkJsonBounds(readerSchema, writerSchema, path)
+	c.checkJsonProperties(readerSchema, writerSchema, path)
+
+	if readerItems, ok := readerSchema["items"]; ok {
+		if writerItems, ok := writerSchema["items"]; ok {
+			c.checkJson(readerItems, writerItems, path+"/items")
+		} else {
+			c.checkJson(readerItems, true, path+"/items")
+		}
+	}
+}
+
+func (c *schemaCompatibilityCheck) checkJsonBounds(readerSchema, writerSchema map[string]interface{}, path string) {
+	// A larger minimum or a smaller maximum in the reader schema rejects values that the writer schema allows
+	for _, keyword := range []string{"minimum", "exclusiveMinimum", "minLength", "minItems", "minProperties"} {
+		readerBound, ok := readerSchema[keyword].(float64)
+		if !ok {
+			continue
+		}
+		if writerBound, ok := writerSchema[keyword].(float64); !ok || readerBound > writerBound {
+			c.addIssue(path, "%q was raised to %s in the %s schema", keyword, jsonValueString(readerBound), c.reader)
+		}
+	}
+	for _, keyword := range []string{"maximum", "exclusiveMaximum", "maxLength", "maxItems", "maxProperties"} {
+		readerBound, ok := readerSchema[keyword].(float64)
+		if !ok {
+			continue
+		}
+		if writerBound, ok := writerSchema[keyword].(float64); !ok || readerBound < writerBound {
+			c.addIssue(path, "%q was lowered to %s in the %s schema", keyword, jsonValueString(readerBound), c.reader)
+		}
+	}
+	if readerPattern, ok := readerSchema["pattern"].(string); ok && readerPattern != writerSchema["pattern"] {
+		c.addIssue(path, "\"pattern\" was changed to %q in the %s schema", readerPattern, c.reader)
+	}
+}
+
+func (c *schemaCompatibilityCheck) checkJsonProperties(readerSchema, writerSchema map[string]interface{}, path string) {
+	readerProperties, _ := readerSchema["properties"].(map[string]interface{})
+	writerProperties, _ := writerSchema["properties"].(map[string]interface{})
+	readerRequired := jsonStringList(readerSchema["required"])
+	writerRequired := jsonStringList(writerSchema["required"])
+
+	for _, property := range readerRequired {
+		if !lo.Contains(writerRequired, property) {
+			c.addIssue(path+"/properties/"+property, "property is required in the %s schema but not in the %s schema", c.reader, c.writer)
+		}
+	}
+
+	// Without "additionalProperties": false, a property that the writer schema doesn't declare can still hold any value
+	writerAdditionalProperties, hasWriterAdditionalProperties := writerSchema["additionalProperties"]
+	if !hasWriterAdditionalProperties {
+		writerAdditionalProperties = true
+	}
+	readerAdditionalProperties, hasReaderAdditionalProperties := readerSchema["additionalProperties"]
+	if !hasReaderAdditionalProperties {
+		readerAdditionalProperties = true
+	}
+
+	for _, property := range sortedKeys(readerProperties) {
+		propertyPath := path + "/properties/" + property
+		if writerProperty, ok := writerProperties[property]; ok {
+			c.checkJson(readerProperties[property], writerProperty, propertyPath)
+			continue
+		}
+		checkBeforeAdding := &schemaCompatibilityCheck{reader: c.reader, writer: c.writer}
+		checkBeforeAdding.checkJson(readerProperties[property], writerAdditionalProperties, propertyPath)
+		if len(checkBeforeAdding.issues) > 0 {
+			c.addIssue(propertyPath, "property was added in the %s schema, but values of the %s schema's additional properties aren't compatible with it", c.reader, c.writer)
+		}
+	}
+	for _, property := range sortedKeys(writerProperties) {
+		if _, ok := readerProperties[property]; ok {
+			continue
+		}
+		propertyPath := path + "/properties/" + property
+		checkAfterRemoving := &schemaCompatibilityCheck{reader: c.reader, writer: c.writer}
+		checkAfterRemoving.checkJson(readerAdditionalProperties, writerProperties[property], propertyPath)
+		if len(checkAfterRemoving.issues) > 0 {
+			c.addIssue(propertyPath, "property was removed in the %s schema, which doesn't allow it as an additional property", c.reader)
+		}
+	}
+	if hasReaderAdditionalProperties {
+		c.checkJson(readerAdditionalProperties, writerAdditionalProperties, path+"/additionalProperties")
+	}
+}
+
+// jsonSchemaAsMap returns an empty map, which accepts any value, for the "true" schema.
+func jsonSchemaAsMap(s interface{}) map[string]interface{} {
+	if v, ok := s.(map[string]interface{}); ok {
+		return v
+	}
+	return map[string]interface{}{}
+}
+
+func jsonSchemaTypes(s map[string]interface{}) []string {
+	switch v := s["type"].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		return jsonStringList(v)
+	}
+	return nil
+}
+
+func jsonStringList(v interface{}) []string {
+	values, _ := v.([]interface{})
+	var result []string
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+func jsonValuesEqual(a, b interface{}) bool {
+	return jsonValueString(a) == jsonValueString(b)
+}
+
+func jsonValueString(v interface{}) string {
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(encoded)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := lo.Keys(m)
+	sort.Strings(keys)
+	return keys
+}
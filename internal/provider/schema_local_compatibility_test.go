@@ -0,0 +1,164 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+const (
+	testAvroUserSchema               = `{"type": "record", "name": "User", "fields": [{"name": "name", "type": "string"}]}`
+	testAvroUserSchemaWithDefault    = `{"type": "record", "name": "User", "fields": [{"name": "name", "type": "string"}, {"name": "age", "type": "int", "default": 0}]}`
+	testAvroUserSchemaWithoutDefault = `{"type": "record", "name": "User", "fields": [{"name": "name", "type": "string"}, {"name": "age", "type": "int"}]}`
+	testJsonUserSchema               = `{"type": "object", "properties": {"name": {"type": "string"}}}`
+	testJsonClosedUserSchema         = `{"type": "object", "properties": {"name": {"type": "string"}}, "additionalProperties": false}`
+)
+
+func TestCheckSchemaCompatibilityLocally(t *testing.T) {
+	tests := []struct {
+		name               string
+		format             string
+		compatibilityLevel string
+		previousSchema     string
+		newSchema          string
+		expectedIssues     []string
+	}{
+		{
+			name:               "AVRO field added with a default value is BACKWARD compatible",
+			format:             avroFormat,
+			compatibilityLevel: compatibilityLevelBackward,
+			previousSchema:     testAvroUserSchema,
+			newSchema:          testAvroUserSchemaWithDefault,
+		},
+		{
+			name:               "AVRO field added without a default value isn't BACKWARD compatible",
+			format:             avroFormat,
+			compatibilityLevel: compatibilityLevelBackwardTransitive,
+			previousSchema:     testAvroUserSchema,
+			newSchema:          testAvroUserSchemaWithoutDefault,
+			expectedIssues:     []string{`"age": field is missing in the previous schema and has no default value in the new schema`},
+		},
+		{
+			name:               "AVRO field added without a default value is FORWARD compatible",
+			format:             avroFormat,
+			compatibilityLevel: compatibilityLevelForward,
+			previousSchema:     testAvroUserSchema,
+			newSchema:          testAvroUserSchemaWithoutDefault,
+		},
+		{
+			name:               "AVRO field removed without a default value isn't FULL compatible",
+			format:             avroFormat,
+			compatibilityLevel: compatibilityLevelFull,
+			previousSchema:     testAvroUserSchemaWithoutDefault,
+			newSchema:          testAvroUserSchema,
+			expectedIssues:     []string{`"age": field is missing in the new schema and has no default value in the previous schema`},
+		},
+		{
+			name:               "AVRO type promotion is BACKWARD compatible only",
+			format:             avroFormat,
+			compatibilityLevel: compatibilityLevelFull,
+			previousSchema:     `{"type": "record", "name": "User", "fields": [{"name": "age", "type": "int"}]}`,
+			newSchema:          `{"type": "record", "name": "User", "fields": [{"name": "age", "type": "long"}]}`,
+			expectedIssues:     []string{`"age": new type "long" can't be read as previous type "int"`},
+		},
+		{
+			name:               "AVRO nested field and enum symbol changes",
+			format:             avroFormat,
+			compatibilityLevel: compatibilityLevelBackward,
+			previousSchema:     `{"type": "record", "name": "User", "fields": [{"name": "address", "type": {"type": "record", "name": "Address", "fields": [{"name": "zip", "type": "int"}]}}, {"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["ACTIVE", "DELETED"]}}]}`,
+			newSchema:          `{"type": "record", "name": "User", "fields": [{"name": "address", "type": {"type": "record", "name": "Address", "fields": [{"name": "zip", "type": "string"}]}}, {"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["ACTIVE"]}}]}`,
+			expectedIssues: []string{
+				`"address.zip": previous type "int" can't be read as new type "string"`,
+				`"status": symbols DELETED of the previous enum are missing in the new enum, which has no default symbol`,
+			},
+		},
+		{
+			name:               "AVRO field made nullable is BACKWARD compatible",
+			format:             avroFormat,
+			compatibilityLevel: compatibilityLevelBackward,
+			previousSchema:     testAvroUserSchema,
+			newSchema:          `{"type": "record", "name": "User", "fields": [{"name": "name", "type": ["null", "string"], "default": null}]}`,
+		},
+		{
+			name:               "NONE skips the check",
+			format:             avroFormat,
+			compatibilityLevel: compatibilityLevelNone,
+			previousSchema:     testAvroUserSchema,
+			newSchema:          `{"type": "record", "name": "Order", "fields": []}`,
+		},
+		{
+			name:               "JSON property added to an open content model isn't BACKWARD compatible",
+			format:             jsonFormat,
+			compatibilityLevel: compatibilityLevelBackward,
+			previousSchema:     testJsonUserSchema,
+			newSchema:          `{"type": "object", "properties": {"name": {"type": "string"}, "age": {"type": "integer"}}}`,
+			expectedIssues:     []string{`"#/properties/age": property was added in the new schema, but values of the previous schema's additional properties aren't compatible with it`},
+		},
+		{
+			name:               "JSON property added to a closed content model is BACKWARD compatible",
+			format:             jsonFormat,
+			compatibilityLevel: compatibilityLevelBackward,
+			previousSchema:     testJsonClosedUserSchema,
+			newSchema:          `{"type": "object", "properties": {"name": {"type": "string"}, "age": {"type": "integer"}}, "additionalProperties": false}`,
+		},
+		{
+			name:               "JSON property removed from a closed content model isn't BACKWARD compatible",
+			format:             jsonFormat,
+			compatibilityLevel: compatibilityLevelBackward,
+			previousSchema:     `{"type": "object", "properties": {"name": {"type": "string"}, "age": {"type": "integer"}}, "additionalProperties": false}`,
+			newSchema:          testJsonClosedUserSchema,
+			expectedIssues:     []string{`"#/properties/age": property was removed in the new schema, which doesn't allow it as an additional property`},
+		},
+		{
+			name:               "JSON required property and narrowed type",
+			format:             jsonFormat,
+			compatibilityLevel: compatibilityLevelFull,
+			previousSchema:     `{"type": "object", "properties": {"name": {"type": "string"}, "age": {"type": "number"}}}`,
+			newSchema:          `{"type": "object", "properties": {"name": {"type": "string"}, "age": {"type": "integer"}}, "required": ["name"]}`,
+			expectedIssues: []string{
+				`"#/properties/name": property is required in the new schema but not in the previous schema`,
+				`"#/properties/age": previous type "number" isn't allowed by new type integer`,
+			},
+		},
+		{
+			name:               "PROTOBUF isn't checked",
+			format:             protobufFormat,
+			compatibilityLevel: compatibilityLevelFull,
+			previousSchema:     `syntax = "proto3"; message User { string name = 1; }`,
+			newSchema:          `syntax = "proto3"; message User { int32 name = 1; }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues, err := checkSchemaCompatibilityLocally(tt.format, tt.compatibilityLevel, tt.previousSchema, tt.newSchema, nil, nil)
+			if err != nil {
+				t.Fatalf("checkSchemaCompatibilityLocally() unexpected error: %v", err)
+			}
+			if len(issues) != 0 || len(tt.expectedIssues) != 0 {
+				if !reflect.DeepEqual(issues, tt.expectedIssues) {
+					t.Errorf("checkSchemaCompatibilityLocally() = %q, expected %q", issues, tt.expectedIssues)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckSchemaCompatibilityLocallyWithUnparsablePreviousSchema(t *testing.T) {
+	if _, err := checkSchemaCompatibilityLocally(avroFormat, compatibilityLevelBackward, "foobar", testAvroUserSchema, nil, nil); err == nil {
+		t.Error("checkSchemaCompatibilityLocally() expected an error for an unparsable previous schema")
+	}
+}
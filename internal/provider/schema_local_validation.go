@@ -0,0 +1,133 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/bufbuild/protocompile/ast"
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/bufbuild/protocompile/reporter"
+	"github.com/hamba/avro/v2"
+	"github.com/samber/lo"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const (
+	localProtobufSchemaFileName = "schema.proto"
+	localJsonSchemaUrl          = "file:///schema.json"
+)
+
+// validateSchemaLocally parses and validates a schema inside the provider, without calling Schema Registry,
+// so it works even when Schema Registry isn't reachable during `terraform plan`.
+// The referenced schemas aren't available offline, so whatever depends on them isn't validated.
+func validateSchemaLocally(ctx context.Context, format, schemaContent string, referenceNames []string) error {
+	switch format {
+	case avroFormat:
+		return validateAvroSchemaLocally(schemaContent, referenceNames)
+	case protobufFormat:
+		return validateProtobufSchemaLocally(ctx, schemaContent, referenceNames)
+	case jsonFormat:
+		return validateJsonSchemaLocally(schemaContent, referenceNames)
+	}
+	return nil
+}
+
+// validateAvroSchemaLocally catches syntax errors, unresolved named types and defaults that don't match their field types.
+// Named types of referenced schemas can't be resolved offline, so schemas with references are only checked for JSON syntax:
+// standing in for them with placeholder types would let defaults and references to their fields through that Schema Registry rejects.
+func validateAvroSchemaLocally(schemaContent string, referenceNames []string) error {
+	if len(referenceNames) > 0 {
+		var document interface{}
+		if err := json.Unmarshal([]byte(schemaContent), &document); err != nil {
+			return fmt.Errorf("invalid JSON document: %s", err)
+		}
+		return nil
+	}
+	if _, err := avro.Parse(schemaContent); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateProtobufSchemaLocally catches syntax errors and imports that don't match any reference. Unresolved types are
+// caught too unless the schema imports files other than the well-known Protobuf types, which aren't available offline.
+func validateProtobufSchemaLocally(ctx context.Context, schemaContent string, referenceNames []string) error {
+	fileNode, err := parser.Parse(localProtobufSchemaFileName, strings.NewReader(schemaContent), reporter.NewHandler(nil))
+	if err != nil {
+		return err
+	}
+	hasUnavailableImports := false
+	for _, decl := range fileNode.Decls {
+		importNode, ok := decl.(*ast.ImportNode)
+		if !ok {
+			continue
+		}
+		importName := importNode.Name.AsString()
+		switch {
+		case strings.HasPrefix(importName, "google/protobuf/"):
+			// Well-known Protobuf types are bundled with the compiler
+		case lo.Contains(referenceNames, importName):
+			hasUnavailableImports = true
+		case strings.HasPrefix(importName, "google/") || strings.HasPrefix(importName, "confluent/"):
+			// Provided by Schema Registry
+			hasUnavailableImports = true
+		default:
+			return fmt.Errorf("import %q doesn't match any schema reference name", importName)
+		}
+	}
+	if hasUnavailableImports {
+		return nil
+	}
+	compiler := protocompile.Compiler{
+		Resolver: protocompile.WithStandardImports(&protocompile.SourceResolver{
+			Accessor: protocompile.SourceAccessorFromMap(map[string]string{localProtobufSchemaFileName: schemaContent}),
+		}),
+	}
+	if _, err := compiler.Compile(ctx, localProtobufSchemaFileName); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validateJsonSchemaLocally catches syntax errors, schemas that don't conform to their draft's metaschema
+// and $ref values that resolve to neither the schema itself nor any reference.
+func validateJsonSchemaLocally(schemaContent string, referenceNames []string) error {
+	compiler := jsonschema.NewCompiler()
+	// Schema Registry uses Draft 7 unless "$schema" is set
+	compiler.Draft = jsonschema.Draft7
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		for _, referenceName := range referenceNames {
+			// For JSON Schema, the reference name is a URL that $ref values resolve to, possibly relative to the schema
+			if url == referenceName || strings.HasSuffix(url, "/"+strings.TrimPrefix(referenceName, "./")) {
+				// Accept any value where the referenced schema is used
+				return io.NopCloser(strings.NewReader("{}")), nil
+			}
+		}
+		return nil, fmt.Errorf("%q doesn't match any schema reference name", url)
+	}
+	if err := compiler.AddResource(localJsonSchemaUrl, strings.NewReader(schemaContent)); err != nil {
+		return err
+	}
+	if _, err := compiler.Compile(localJsonSchemaUrl); err != nil {
+		return err
+	}
+	return nil
+}
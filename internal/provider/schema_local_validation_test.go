@@ -0,0 +1,134 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestValidateSchemaLocally(t *testing.T) {
+	tests := []struct {
+		name           string
+		format         string
+		schemaContent  string
+		referenceNames []string
+		expectErr      bool
+	}{
+		{
+			name:          "valid AVRO record",
+			format:        avroFormat,
+			schemaContent: `{"type": "record", "name": "User", "fields": [{"name": "age", "type": "int", "default": 0}]}`,
+		},
+		{
+			name:          "AVRO syntax error",
+			format:        avroFormat,
+			schemaContent: `{"type": "record", "name": "User", "fields": [}`,
+			expectErr:     true,
+		},
+		{
+			name:          "AVRO invalid default",
+			format:        avroFormat,
+			schemaContent: `{"type": "record", "name": "User", "fields": [{"name": "age", "type": "int", "default": "zero"}]}`,
+			expectErr:     true,
+		},
+		{
+			name:          "AVRO unresolved named type",
+			format:        avroFormat,
+			schemaContent: `{"type": "record", "name": "User", "fields": [{"name": "address", "type": "io.confluent.Address"}]}`,
+			expectErr:     true,
+		},
+		{
+			name:           "AVRO named type from a reference",
+			format:         avroFormat,
+			schemaContent:  `{"type": "record", "name": "User", "namespace": "io.confluent", "fields": [{"name": "address", "type": "Address"}]}`,
+			referenceNames: []string{"io.confluent.Address"},
+		},
+		{
+			// The referenced type isn't available offline, so the default can't be checked against it
+			name:           "AVRO default of a named type from a reference",
+			format:         avroFormat,
+			schemaContent:  `{"type": "record", "name": "User", "namespace": "io.confluent", "fields": [{"name": "address", "type": "Address", "default": {"street": "Main St"}}]}`,
+			referenceNames: []string{"io.confluent.Address"},
+		},
+		{
+			name:           "AVRO syntax error with a reference",
+			format:         avroFormat,
+			schemaContent:  `{"type": "record", "name": "User", "namespace": "io.confluent", "fields": [{"name": "address", "type": "Address"},]}`,
+			referenceNames: []string{"io.confluent.Address"},
+			expectErr:      true,
+		},
+		{
+			name:          "valid PROTOBUF message",
+			format:        protobufFormat,
+			schemaContent: `syntax = "proto3"; import "google/protobuf/timestamp.proto"; message User { google.protobuf.Timestamp created_at = 1; }`,
+		},
+		{
+			name:          "PROTOBUF syntax error",
+			format:        protobufFormat,
+			schemaContent: `syntax = "proto3"; message User { int32 age = 1 }`,
+			expectErr:     true,
+		},
+		{
+			name:          "PROTOBUF unresolved type",
+			format:        protobufFormat,
+			schemaContent: `syntax = "proto3"; message User { Address address = 1; }`,
+			expectErr:     true,
+		},
+		{
+			name:          "PROTOBUF import without a reference",
+			format:        protobufFormat,
+			schemaContent: `syntax = "proto3"; import "address.proto"; message User { Address address = 1; }`,
+			expectErr:     true,
+		},
+		{
+			name:           "PROTOBUF import from a reference",
+			format:         protobufFormat,
+			schemaContent:  `syntax = "proto3"; import "address.proto"; message User { Address address = 1; }`,
+			referenceNames: []string{"address.proto"},
+		},
+		{
+			name:          "valid JSON Schema",
+			format:        jsonFormat,
+			schemaContent: `{"type": "object", "definitions": {"age": {"type": "integer"}}, "properties": {"age": {"$ref": "#/definitions/age"}}}`,
+		},
+		{
+			name:          "JSON Schema that doesn't conform to the metaschema",
+			format:        jsonFormat,
+			schemaContent: `{"type": "map"}`,
+			expectErr:     true,
+		},
+		{
+			name:          "JSON Schema unresolved $ref",
+			format:        jsonFormat,
+			schemaContent: `{"type": "object", "properties": {"address": {"$ref": "address.json"}}}`,
+			expectErr:     true,
+		},
+		{
+			name:           "JSON Schema $ref to a reference",
+			format:         jsonFormat,
+			schemaContent:  `{"$id": "https://example.com/user.json", "type": "object", "properties": {"address": {"$ref": "address.json"}}}`,
+			referenceNames: []string{"address.json"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSchemaLocally(context.Background(), tt.format, tt.schemaContent, tt.referenceNames)
+			if (err != nil) != tt.expectErr {
+				t.Errorf("validateSchemaLocally() error = %v, expectErr %v", err, tt.expectErr)
+			}
+		})
+	}
+}
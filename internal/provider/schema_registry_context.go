@@ -0,0 +1,128 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	qualifiedSubjectNamePrefix = ":."
+	contextSeparator           = ":"
+)
+
+func subjectContextSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		ForceNew:         true,
+		Description:      "The Schema Registry context of the subject, for example, `.team-a`. Defaults to the default context.",
+		ValidateFunc:     validation.StringDoesNotContainAny(contextSeparator),
+		DiffSuppressFunc: subjectContextDiffSuppressFunc,
+	}
+}
+
+func subjectContextDataSourceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         true,
+		Description:      "The Schema Registry context of the subject, for example, `.team-a`. Defaults to the default context.",
+		ValidateFunc:     validation.StringDoesNotContainAny(contextSeparator),
+		DiffSuppressFunc: subjectContextDiffSuppressFunc,
+	}
+}
+
+// subjectContextDiffSuppressFunc ignores the leading dot of a context name, so that both "team-a" and ".team-a" are accepted,
+// as well as the difference between "." and an empty value, which both stand for the default context.
+func subjectContextDiffSuppressFunc(_, oldContext, newContext string, _ *schema.ResourceData) bool {
+	return strings.TrimPrefix(oldContext, ".") == strings.TrimPrefix(newContext, ".")
+}
+
+// subjectNameDiffSuppressFunc ignores the difference between a qualified subject name and the same subject name
+// with the context set separately, so that moving the context of an existing subject to the context attribute doesn't recreate it.
+func subjectNameDiffSuppressFunc(_, oldSubjectName, newSubjectName string, d *schema.ResourceData) bool {
+	if oldSubjectName == "" || newSubjectName == "" {
+		return false
+	}
+	context := d.Get(paramContext).(string)
+	return qualifySubjectName(context, oldSubjectName) == qualifySubjectName(context, newSubjectName)
+}
+
+// qualifySubjectName returns the name that Schema Registry uses for a subject in a context, for example, ":.team-a:orders-value".
+// Subject names that are already qualified, which is how contexts were set before the context attribute was added, are returned as is.
+func qualifySubjectName(context, subjectName string) string {
+	context = strings.TrimPrefix(context, ".")
+	if context == "" || isQualifiedSubjectName(subjectName) {
+		return subjectName
+	}
+	return fmt.Sprintf("%s%s%s%s", qualifiedSubjectNamePrefix, context, contextSeparator, subjectName)
+}
+
+func isQualifiedSubjectName(subjectName string) bool {
+	return strings.HasPrefix(subjectName, qualifiedSubjectNamePrefix) && strings.Contains(subjectName[len(qualifiedSubjectNamePrefix):], contextSeparator)
+}
+
+// splitQualifiedSubjectName splits a qualified subject name into its context, with a leading dot, and its name within the context.
+// Subjects in the default context have an empty context.
+func splitQualifiedSubjectName(subjectName string) (string, string) {
+	if !isQualifiedSubjectName(subjectName) {
+		return "", subjectName
+	}
+	context, name, _ := strings.Cut(subjectName[len(qualifiedSubjectNamePrefix):], contextSeparator)
+	if context == "" {
+		return "", name
+	}
+	return "." + context, name
+}
+
+// extractClusterIdAndSubjectNameFromTfId parses IDs in the format <Schema Registry cluster ID>/<subject name>,
+// where the subject name may contain forward slashes and may be qualified with a context.
+func extractClusterIdAndSubjectNameFromTfId(terraformId string) (string, string, error) {
+	parts := strings.Split(terraformId, "/")
+	if len(parts) < 2 {
+		return "", "", fmt.Errorf("invalid format: expected '<SR cluster ID>/<subject name>' where subject name may contain forward slashes and may be qualified with a context, for example, ':.team-a:orders-value'")
+	}
+	subjectName := strings.Join(parts[1:], "/")
+	if err := validateQualifiedSubjectName(subjectName); err != nil {
+		return "", "", err
+	}
+	return parts[0], subjectName, nil
+}
+
+func validateQualifiedSubjectName(subjectName string) error {
+	if strings.HasPrefix(subjectName, qualifiedSubjectNamePrefix) && !isQualifiedSubjectName(subjectName) {
+		return fmt.Errorf("invalid subject name %q: expected '%s<context>%s<subject name>' for a subject in a context", subjectName, qualifiedSubjectNamePrefix, contextSeparator)
+	}
+	return nil
+}
+
+// setSubjectNameAndContext sets the subject name and context attributes from the qualified subject name that Schema Registry returns.
+// The subject name stays qualified if it was set that way.
+func setSubjectNameAndContext(d *schema.ResourceData, qualifiedSubjectName string) error {
+	context, subjectName := splitQualifiedSubjectName(qualifiedSubjectName)
+	if isQualifiedSubjectName(d.Get(paramSubjectName).(string)) {
+		subjectName = qualifiedSubjectName
+	}
+	if err := d.Set(paramSubjectName, subjectName); err != nil {
+		return err
+	}
+	return d.Set(paramContext, context)
+}
@@ -0,0 +1,77 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestQualifySubjectName(t *testing.T) {
+	tests := []struct {
+		context     string
+		subjectName string
+		expected    string
+	}{
+		{"", "orders-value", "orders-value"},
+		{".", "orders-value", "orders-value"},
+		{".team-a", "orders-value", ":.team-a:orders-value"},
+		{"team-a", "orders-value", ":.team-a:orders-value"},
+		{".team-a", ":.team-a:orders-value", ":.team-a:orders-value"},
+		{".team-a", "", ":.team-a:"},
+	}
+
+	for _, tt := range tests {
+		if actual := qualifySubjectName(tt.context, tt.subjectName); actual != tt.expected {
+			t.Errorf("qualifySubjectName(%q, %q) = %q, expected %q", tt.context, tt.subjectName, actual, tt.expected)
+		}
+	}
+}
+
+func TestSplitQualifiedSubjectName(t *testing.T) {
+	tests := []struct {
+		qualifiedSubjectName string
+		expectedContext      string
+		expectedSubjectName  string
+	}{
+		{"orders-value", "", "orders-value"},
+		{":.team-a:orders-value", ".team-a", "orders-value"},
+		{":.team-a:orders:value", ".team-a", "orders:value"},
+		{":.:orders-value", "", "orders-value"},
+		{":.team-a", "", ":.team-a"},
+	}
+
+	for _, tt := range tests {
+		context, subjectName := splitQualifiedSubjectName(tt.qualifiedSubjectName)
+		if context != tt.expectedContext || subjectName != tt.expectedSubjectName {
+			t.Errorf("splitQualifiedSubjectName(%q) = (%q, %q), expected (%q, %q)", tt.qualifiedSubjectName, context, subjectName, tt.expectedContext, tt.expectedSubjectName)
+		}
+	}
+}
+
+func TestExtractClusterIdAndSubjectNameFromTfId(t *testing.T) {
+	clusterId, subjectName, err := extractClusterIdAndSubjectNameFromTfId("lsrc-abc123/:.team-a:orders/value")
+	if err != nil {
+		t.Fatalf("extractClusterIdAndSubjectNameFromTfId() unexpected error: %v", err)
+	}
+	if clusterId != "lsrc-abc123" || subjectName != ":.team-a:orders/value" {
+		t.Errorf("extractClusterIdAndSubjectNameFromTfId() = (%q, %q), expected (%q, %q)", clusterId, subjectName, "lsrc-abc123", ":.team-a:orders/value")
+	}
+	if _, _, err := extractClusterIdAndSubjectNameFromTfId("lsrc-abc123/:.team-a"); err == nil {
+		t.Error("extractClusterIdAndSubjectNameFromTfId() expected an error for a subject name without a context separator")
+	}
+	if _, _, err := extractClusterIdAndSubjectNameFromTfId("lsrc-abc123"); err == nil {
+		t.Error("extractClusterIdAndSubjectNameFromTfId() expected an error for an ID without a subject name")
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	jsonata "github.com/blues/jsonata-go"
+	schemaregistryv1 "github.com/confluentinc/ccloud-sdk-go-v2/schema-registry/v1"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/samber/lo"
+)
+
+const (
+	ruleKindCondition = "CONDITION"
+	ruleKindTransform = "TRANSFORM"
+
+	ruleModeWrite     = "WRITE"
+	ruleModeRead      = "READ"
+	ruleModeWriteRead = "WRITEREAD"
+	ruleModeUpgrade   = "UPGRADE"
+	ruleModeDowngrade = "DOWNGRADE"
+	ruleModeUpDown    = "UPDOWN"
+
+	ruleTypeCel            = "CEL"
+	ruleTypeCelField       = "CEL_FIELD"
+	ruleTypeJsonata        = "JSONATA"
+	ruleTypeEncrypt        = "ENCRYPT"
+	ruleTypeEncryptPayload = "ENCRYPT_PAYLOAD"
+
+	// A CEL expression may start with a guard, for example, "name == 'ssn' ; value.size() == 9",
+	// in which case the rule only applies when the guard is true
+	celGuardSeparator = ";"
+)
+
+var (
+	ruleKinds          = []string{ruleKindCondition, ruleKindTransform}
+	domainRuleModes    = []string{ruleModeWrite, ruleModeRead, ruleModeWriteRead}
+	migrationRuleModes = []string{ruleModeUpgrade, ruleModeDowngrade, ruleModeUpDown}
+	// The same format as the names of Stream Catalog tags, see confluent_tag
+	ruleTagNameRegex = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_\s]*$`)
+)
+
+// ruleCategory describes one of the lists of a ruleset. Rule types that aren't listed in types are handled
+// by custom rule executors, so only their kind and mode are checked.
+type ruleCategory struct {
+	param string
+	modes []string
+	types []string
+}
+
+var ruleCategories = []ruleCategory{
+	{param: paramDomainRules, modes: domainRuleModes, types: []string{ruleTypeCel, ruleTypeCelField, ruleTypeEncrypt}},
+	{param: paramMigrationRules, modes: migrationRuleModes, types: []string{ruleTypeCel, ruleTypeJsonata}},
+	{param: paramEncodingRules, modes: domainRuleModes, types: []string{ruleTypeEncrypt, ruleTypeEncryptPayload}},
+}
+
+var builtInRuleTypes = []string{ruleTypeCel, ruleTypeCelField, ruleTypeJsonata, ruleTypeEncrypt, ruleTypeEncryptPayload}
+
+// schemaRulesetCustomizeDiff validates the rules of a ruleset during `terraform plan`, so that a typo in
+// an expression is reported before the schema is registered rather than when producers start failing.
+func schemaRulesetCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.HasChange(paramRuleset) || !diff.NewValueKnown(paramRuleset) {
+		return nil
+	}
+	if issues := validateRuleset(extractRules(diff.Get(paramRuleset))); len(issues) > 0 {
+		return fmt.Errorf("error validating Schema rules:\n- %s", strings.Join(issues, "\n- "))
+	}
+	return nil
+}
+
+// unreferencedRuleTagsWarning warns about the tags of the rules that neither the schema nor its metadata refer to.
+// Tags can also be applied to fields in Stream Catalog, which is out of reach here, so it's a warning rather than an error.
+func unreferencedRuleTagsWarning(d *schema.ResourceData) diag.Diagnostics {
+	tags := findUnreferencedRuleTags(extractRules(d.Get(paramRuleset)), d.Get(paramSchema).(string), extractMetadataTags(d.Get(paramMetadata)))
+	if len(tags) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  "Tags of Schema rules aren't referenced",
+			Detail:   fmt.Sprintf("Tags %s of Schema rules aren't referenced by the schema or its metadata, so the rules don't apply to any field unless the tags are applied to fields in Stream Catalog.", strings.Join(lo.Map(tags, func(tag string, _ int) string { return fmt.Sprintf("%q", tag) }), ", ")),
+		},
+	}
+}
+
+func extractRules(tfRuleset interface{}) map[string][]schemaregistryv1.Rule {
+	rules := make(map[string][]schemaregistryv1.Rule)
+	if tfRulesetList := tfRuleset.([]interface{}); len(tfRulesetList) == 1 && tfRulesetList[0] != nil {
+		tfRulesetMap := tfRulesetList[0].(map[string]interface{})
+		for _, category := range ruleCategories {
+			if tfRules, ok := tfRulesetMap[category.param].(*schema.Set); ok {
+				rules[category.param] = buildRules(tfRules.List())
+			}
+		}
+	}
+	return rules
+}
+
+// validateRuleset returns every issue with the rules of a ruleset, keyed by the attribute of their list,
+// with the path of the rule, for example, `ruleset.0.domain_rules["checkSsn"].expr: ...`.
+func validateRuleset(rules map[string][]schemaregistryv1.Rule) []string {
+	var issues []string
+	for _, category := range ruleCategories {
+		seenNames := make(map[string]bool)
+		for _, rule := range rules[category.param] {
+			path := fmt.Sprintf("%s.0.%s[%q]", paramRuleset, category.param, rule.GetName())
+			if seenNames[rule.GetName()] {
+				issues = append(issues, fmt.Sprintf("%s: rule names must be unique within %q", path, category.param))
+			}
+			seenNames[rule.GetName()] = true
+			for _, err := range validateRule(category, rule) {
+				issues = append(issues, fmt.Sprintf("%s.%s", path, err))
+			}
+		}
+	}
+	return issues
+}
+
+// validateRule checks the combination of kind, mode and type of a rule, its tags and, for the built-in rule types, its expression.
+// Each issue starts with the attribute it's about.
+func validateRule(category ruleCategory, rule schemaregistryv1.Rule) []string {
+	var issues []string
+	kind, mode, ruleType, expr := rule.GetKind(), rule.GetMode(), rule.GetType(), rule.GetExpr()
+	if !lo.Contains(ruleKinds, kind) {
+		issues = append(issues, fmt.Sprintf("%s: expected one of %s, got %q", paramKind, strings.Join(ruleKinds, ", "), kind))
+	}
+	if !lo.Contains(category.modes, mode) {
+		issues = append(issues, fmt.Sprintf("%s: expected one of %s for %q, got %q", paramMode, strings.Join(category.modes, ", "), category.param, mode))
+	}
+	if lo.Contains(builtInRuleTypes, ruleType) && !lo.Contains(category.types, ruleType) {
+		issues = append(issues, fmt.Sprintf("%s: %q rules aren't supported in %q, expected one of %s", paramType, ruleType, category.param, strings.Join(category.types, ", ")))
+	}
+	for _, tag := range rule.GetTags() {
+		if !ruleTagNameRegex.MatchString(tag) {
+			issues = append(issues, fmt.Sprintf("%s: invalid tag %q, expected a letter followed by a sequence of letter, number, space, or _ characters", paramTags, tag))
+		}
+	}
+
+	switch ruleType {
+	case ruleTypeCel, ruleTypeCelField:
+		if expr == "" {
+			issues = append(issues, fmt.Sprintf("%s: an expression is required for %q rules", paramExpr, ruleType))
+		} else if err := validateCelRuleExpression(ruleType, kind, expr); err != nil {
+			issues = append(issues, fmt.Sprintf("%s: %s", paramExpr, err))
+		}
+	case ruleTypeJsonata:
+		if kind != ruleKindTransform {
+			issues = append(issues, fmt.Sprintf("%s: %q rules must be %q rules", paramKind, ruleType, ruleKindTransform))
+		}
+		if expr == "" {
+			issues = append(issues, fmt.Sprintf("%s: an expression is required for %q rules", paramExpr, ruleType))
+		} else if err := validateJsonataExpression(expr); err != nil {
+			issues = append(issues, fmt.Sprintf("%s: %s", paramExpr, err))
+		}
+	case ruleTypeEncrypt, ruleTypeEncryptPayload:
+		if kind != ruleKindTransform {
+			issues = append(issues, fmt.Sprintf("%s: %q rules must be %q rules", paramKind, ruleType, ruleKindTransform))
+		}
+		// Field level encryption applies to the fields with the tags of the rule, so without tags nothing is encrypted
+		if ruleType == ruleTypeEncrypt && category.param == paramDomainRules && len(rule.GetTags()) == 0 {
+			issues = append(issues, fmt.Sprintf("%s: at least one tag is required for %q rules", paramTags, ruleType))
+		}
+	}
+	return issues
+}
+
+// validateCelRuleExpression compiles a CEL expression in an environment with the variables that Confluent's
+// serializers provide: the message for "CEL" rules, and the field value, name, type name and tags for "CEL_FIELD" rules.
+// The message is dynamically typed, since its type comes from the schema.
+func validateCelRuleExpression(ruleType, kind, expr string) error {
+	env, err := newCelRuleEnv(ruleType)
+	if err != nil {
+		return err
+	}
+	if guard, body, found := cutCelGuard(expr); found {
+		if err := compileCelExpression(env, guard, true); err != nil {
+			return fmt.Errorf("invalid guard: %s", err)
+		}
+		expr = body
+	}
+	return compileCelExpression(env, expr, kind == ruleKindCondition)
+}
+
+// cutCelGuard splits a CEL expression at the first guard separator that isn't inside a string literal,
+// for example, "name == 'a;b' ; value.size() > 0" has the guard "name == 'a;b' ".
+func cutCelGuard(expr string) (guard, body string, found bool) {
+	// The delimiter of the string literal the current character is in, if any
+	quote := ""
+	isRaw := false
+	for i := 0; i < len(expr); i++ {
+		switch {
+		case quote == "" && (strings.HasPrefix(expr[i:], `"""`) || strings.HasPrefix(expr[i:], `'''`)):
+			quote, isRaw = expr[i:i+3], i > 0 && (expr[i-1] == 'r' || expr[i-1] == 'R')
+			i += 2
+		case quote == "" && (expr[i] == '"' || expr[i] == '\''):
+			quote, isRaw = expr[i:i+1], i > 0 && (expr[i-1] == 'r' || expr[i-1] == 'R')
+		case quote == "" && strings.HasPrefix(expr[i:], celGuardSeparator):
+			return expr[:i], expr[i+len(celGuardSeparator):], true
+		case quote != "" && !isRaw && expr[i] == '\\':
+			i++
+		case quote != "" && strings.HasPrefix(expr[i:], quote):
+			i += len(quote) - 1
+			quote = ""
+		}
+	}
+	return expr, "", false
+}
+
+func compileCelExpression(env *cel.Env, expr string, expectBool bool) error {
+	compiled, issues := env.Compile(strings.TrimSpace(expr))
+	if issues != nil && issues.Err() != nil {
+		return fmt.Errorf("invalid CEL expression: %s", issues.Err())
+	}
+	if expectBool && !compiled.OutputType().IsAssignableType(cel.BoolType) {
+		return fmt.Errorf("expected a CEL expression that returns a bool, got %s", compiled.OutputType())
+	}
+	return nil
+}
+
+// validateJsonataExpression compiles a JSONata expression with the JSONata implementation that confluent-kafka-go's
+// serializers use, so that expressions they can't compile are reported during terraform plan.
+func validateJsonataExpression(expr string) error {
+	if _, err := jsonata.Compile(expr); err != nil {
+		return fmt.Errorf("invalid JSONata expression: %s", err)
+	}
+	return nil
+}
+
+func newCelRuleEnv(ruleType string) (*cel.Env, error) {
+	options := []cel.EnvOption{
+		ext.Strings(),
+		ext.Encoders(),
+		ext.Lists(),
+		ext.Math(),
+		ext.Sets(),
+		cel.Variable("message", cel.DynType),
+	}
+	// Confluent's serializers add validators for common string formats, for example, `value.isEmail()`
+	for _, function := range []string{"isEmail", "isHostname", "isIpv4", "isIpv6", "isUri", "isUriRef", "isUuid"} {
+		options = append(options, cel.Function(function, cel.MemberOverload(fmt.Sprintf("string_%s", function), []*cel.Type{cel.StringType}, cel.BoolType)))
+	}
+	if ruleType == ruleTypeCelField {
+		options = append(options,
+			cel.Variable("value", cel.DynType),
+			cel.Variable("name", cel.StringType),
+			cel.Variable("typeName", cel.StringType),
+			cel.Variable("tags", cel.ListType(cel.StringType)),
+		)
+	}
+	return cel.NewEnv(options...)
+}
+
+// findUnreferencedRuleTags returns the tags of the rules that neither the schema, for example, with `confluent:tags`,
+// nor the tags of its metadata refer to.
+func findUnreferencedRuleTags(rules map[string][]schemaregistryv1.Rule, schemaContent string, metadataTags []string) []string {
+	var unreferencedTags []string
+	for _, category := range ruleCategories {
+		for _, rule := range rules[category.param] {
+			for _, tag := range rule.GetTags() {
+				if lo.Contains(metadataTags, tag) || strings.Contains(schemaContent, fmt.Sprintf("%q", tag)) || lo.Contains(unreferencedTags, tag) {
+					continue
+				}
+				unreferencedTags = append(unreferencedTags, tag)
+			}
+		}
+	}
+	return unreferencedTags
+}
+
+func extractMetadataTags(tfMetadata interface{}) []string {
+	var tags []string
+	if tfMetadataList := tfMetadata.([]interface{}); len(tfMetadataList) == 1 && tfMetadataList[0] != nil {
+		if tfTags, ok := tfMetadataList[0].(map[string]interface{})[paramTags].(*schema.Set); ok {
+			for _, tagsOfPath := range convertToStringStringListMap(tfTags.List()) {
+				tags = append(tags, tagsOfPath...)
+			}
+		}
+	}
+	return tags
+}
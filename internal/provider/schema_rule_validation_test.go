@@ -0,0 +1,306 @@
+// Copyright 2021 Confluent Inc. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	schemaregistryv1 "github.com/confluentinc/ccloud-sdk-go-v2/schema-registry/v1"
+)
+
+func newTestRule(name, kind, mode, ruleType, expr string, tags ...string) schemaregistryv1.Rule {
+	rule := schemaregistryv1.NewRule()
+	rule.SetName(name)
+	rule.SetKind(kind)
+	rule.SetMode(mode)
+	rule.SetType(ruleType)
+	rule.SetExpr(expr)
+	rule.SetTags(tags)
+	return *rule
+}
+
+func TestValidateRuleset(t *testing.T) {
+	tests := []struct {
+		name           string
+		rules          map[string][]schemaregistryv1.Rule
+		expectedIssues []string
+	}{
+		{
+			name: "valid rules",
+			rules: map[string][]schemaregistryv1.Rule{
+				paramDomainRules: {
+					newTestRule("checkSsnLen", ruleKindCondition, ruleModeWrite, ruleTypeCel, "size(message.ssn) == 9"),
+					newTestRule("checkEmail", ruleKindCondition, ruleModeWrite, ruleTypeCelField, "name == 'email' ; value.isEmail()"),
+					newTestRule("maskPii", ruleKindTransform, ruleModeWrite, ruleTypeCelField, "'PII' in tags ; 'XXX'", "PII"),
+					newTestRule("encryptPII", ruleKindTransform, ruleModeWriteRead, ruleTypeEncrypt, "", "PII"),
+					newTestRule("custom", ruleKindCondition, ruleModeRead, "CUSTOM_EXECUTOR", "anything goes"),
+				},
+				paramMigrationRules: {
+					newTestRule("upgradeV2", ruleKindTransform, ruleModeUpgrade, ruleTypeJsonata, `$merge([$sift($, function($v, $k) {$k != 'state'}), {'status': $.state}])`),
+				},
+				paramEncodingRules: {
+					newTestRule("encryptPayload", ruleKindTransform, ruleModeWriteRead, ruleTypeEncryptPayload, ""),
+				},
+			},
+		},
+		{
+			name: "invalid kind and mode",
+			rules: map[string][]schemaregistryv1.Rule{
+				paramDomainRules: {
+					newTestRule("checkSsnLen", "VALIDATE", ruleModeUpgrade, ruleTypeCel, "size(message.ssn) == 9"),
+				},
+			},
+			expectedIssues: []string{
+				`ruleset.0.domain_rules["checkSsnLen"].kind: expected one of CONDITION, TRANSFORM, got "VALIDATE"`,
+				`ruleset.0.domain_rules["checkSsnLen"].mode: expected one of WRITE, READ, WRITEREAD for "domain_rules", got "UPGRADE"`,
+			},
+		},
+		{
+			name: "rule types in the wrong list",
+			rules: map[string][]schemaregistryv1.Rule{
+				paramDomainRules: {
+					newTestRule("upgradeV2", ruleKindTransform, ruleModeWrite, ruleTypeJsonata, "$"),
+				},
+				paramMigrationRules: {
+					newTestRule("encryptPII", ruleKindTransform, ruleModeUpDown, ruleTypeEncrypt, "", "PII"),
+				},
+			},
+			expectedIssues: []string{
+				`ruleset.0.domain_rules["upgradeV2"].type: "JSONATA" rules aren't supported in "domain_rules", expected one of CEL, CEL_FIELD, ENCRYPT`,
+				`ruleset.0.migration_rules["encryptPII"].type: "ENCRYPT" rules aren't supported in "migration_rules", expected one of CEL, JSONATA`,
+			},
+		},
+		{
+			name: "duplicate names",
+			rules: map[string][]schemaregistryv1.Rule{
+				paramDomainRules: {
+					newTestRule("check", ruleKindCondition, ruleModeWrite, ruleTypeCel, "true"),
+					newTestRule("check", ruleKindCondition, ruleModeRead, ruleTypeCel, "true"),
+				},
+			},
+			expectedIssues: []string{
+				`ruleset.0.domain_rules["check"]: rule names must be unique within "domain_rules"`,
+			},
+		},
+		{
+			name: "tags",
+			rules: map[string][]schemaregistryv1.Rule{
+				paramDomainRules: {
+					newTestRule("encryptPII", ruleKindTransform, ruleModeWriteRead, ruleTypeEncrypt, ""),
+					newTestRule("maskPii", ruleKindTransform, ruleModeWrite, ruleTypeCelField, "'XXX'", "PII-1"),
+				},
+			},
+			expectedIssues: []string{
+				`ruleset.0.domain_rules["encryptPII"].tags: at least one tag is required for "ENCRYPT" rules`,
+				`ruleset.0.domain_rules["maskPii"].tags: invalid tag "PII-1", expected a letter followed by a sequence of letter, number, space, or _ characters`,
+			},
+		},
+		{
+			name: "missing expressions",
+			rules: map[string][]schemaregistryv1.Rule{
+				paramDomainRules: {
+					newTestRule("checkSsnLen", ruleKindCondition, ruleModeWrite, ruleTypeCel, ""),
+				},
+				paramMigrationRules: {
+					newTestRule("upgradeV2", ruleKindCondition, ruleModeUpgrade, ruleTypeJsonata, ""),
+				},
+			},
+			expectedIssues: []string{
+				`ruleset.0.domain_rules["checkSsnLen"].expr: an expression is required for "CEL" rules`,
+				`ruleset.0.migration_rules["upgradeV2"].kind: "JSONATA" rules must be "TRANSFORM" rules`,
+				`ruleset.0.migration_rules["upgradeV2"].expr: an expression is required for "JSONATA" rules`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := validateRuleset(tt.rules); !reflect.DeepEqual(actual, tt.expectedIssues) {
+				t.Errorf("validateRuleset() = %#v, expected %#v", actual, tt.expectedIssues)
+			}
+		})
+	}
+}
+
+func TestValidateCelRuleExpression(t *testing.T) {
+	tests := []struct {
+		name        string
+		ruleType    string
+		kind        string
+		expr        string
+		expectedErr string
+	}{
+		{
+			name:     "condition",
+			ruleType: ruleTypeCel,
+			kind:     ruleKindCondition,
+			expr:     "message.name != '' && message.age >= 18",
+		},
+		{
+			name:     "transform with a guard",
+			ruleType: ruleTypeCelField,
+			kind:     ruleKindTransform,
+			expr:     "typeName == 'STRING' ; value.upperAscii()",
+		},
+		{
+			name:        "syntax error",
+			ruleType:    ruleTypeCel,
+			kind:        ruleKindCondition,
+			expr:        "message.name == 'x' &&",
+			expectedErr: "invalid CEL expression: ERROR: <input>:1:23: Syntax error",
+		},
+		{
+			name:        "undeclared variable",
+			ruleType:    ruleTypeCel,
+			kind:        ruleKindCondition,
+			expr:        "value == ''",
+			expectedErr: "undeclared reference to 'value'",
+		},
+		{
+			name:        "condition that doesn't return a bool",
+			ruleType:    ruleTypeCelField,
+			kind:        ruleKindCondition,
+			expr:        "name + 'x'",
+			expectedErr: "expected a CEL expression that returns a bool, got string",
+		},
+		{
+			name:     "separator in string literals",
+			ruleType: ruleTypeCelField,
+			kind:     ruleKindCondition,
+			expr:     `name == 'a;b' || name == "c;d" || name == """e;f""" || name == 'g\';' ; value != r'\'`,
+		},
+		{
+			name:     "separator in a string literal without a guard",
+			ruleType: ruleTypeCel,
+			kind:     ruleKindCondition,
+			expr:     "message.name != 'a;b'",
+		},
+		{
+			name:        "guard that doesn't return a bool",
+			ruleType:    ruleTypeCelField,
+			kind:        ruleKindTransform,
+			expr:        "typeName ; value",
+			expectedErr: "invalid guard: expected a CEL expression that returns a bool, got string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCelRuleExpression(tt.ruleType, tt.kind, tt.expr)
+			if tt.expectedErr == "" && err != nil {
+				t.Errorf("validateCelRuleExpression() unexpected error: %s", err)
+			}
+			if tt.expectedErr != "" && (err == nil || !strings.Contains(err.Error(), tt.expectedErr)) {
+				t.Errorf("validateCelRuleExpression() error = %v, expected %q", err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestValidateJsonataExpression(t *testing.T) {
+	tests := []struct {
+		name        string
+		expr        string
+		expectedErr string
+	}{
+		{
+			name: "path with a filter and a function",
+			expr: `$sum(Account.Order[Price > 10].(Price * Quantity))`,
+		},
+		{
+			name: "block with variables and a regular expression",
+			expr: "(\n  $email := $lowercase(`e-mail`);\n  $merge([$, {'domain': $match($email, /@(.+)$/i).groups[0]}])\n)",
+		},
+		{
+			name: "conditions, sorting, wildcards and partial application",
+			expr: `Account.Order^(>Price, <Quantity).* ~> $map($substring(?, 0, 5)) ? -1 : [1..3]`,
+		},
+		{
+			name: "transform",
+			expr: `$ ~> | Order | {"total": 0}, ["legacy"] |`,
+		},
+		{
+			name: "brackets in strings and names",
+			expr: "{'status)': $.`state]`, \"note\": \"it's {done\"}",
+		},
+		{
+			name: "division after a closing bracket, a name and a number",
+			expr: `(Price + 1) / 2 + Quantity / 3 + 10 / 5`,
+		},
+		{
+			name:        "blank expression",
+			expr:        "  ",
+			expectedErr: "invalid JSONata expression: unexpected end of expression",
+		},
+		{
+			name:        "unterminated string",
+			expr:        `{'status': $.state, 'version": 2}`,
+			expectedErr: "invalid JSONata expression: unterminated string literal (no closing ''')",
+		},
+		{
+			name:        "unbalanced brackets",
+			expr:        `$merge([$, {'status': 1})`,
+			expectedErr: "invalid JSONata expression: expected token ']', got ')'",
+		},
+		{
+			name:        "unclosed bracket",
+			expr:        `$merge([$, {'status': 1}]`,
+			expectedErr: "invalid JSONata expression: expected token ')' before end of expression",
+		},
+		{
+			name:        "escape sequence that JSON doesn't support",
+			expr:        `'it\'s'`,
+			expectedErr: `invalid JSONata expression: illegal escape sequence \'`,
+		},
+		{
+			name:        "division by nothing",
+			expr:        `Price = / 2`,
+			expectedErr: "invalid JSONata expression: unterminated regular expression (no closing '/')",
+		},
+		{
+			name:        "unterminated name",
+			expr:        "$.`e-mail",
+			expectedErr: "invalid JSONata expression: unterminated name (no closing '`')",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateJsonataExpression(tt.expr)
+			if tt.expectedErr == "" && err != nil {
+				t.Errorf("validateJsonataExpression() unexpected error: %s", err)
+			}
+			if tt.expectedErr != "" && (err == nil || err.Error() != tt.expectedErr) {
+				t.Errorf("validateJsonataExpression() error = %v, expected %q", err, tt.expectedErr)
+			}
+		})
+	}
+}
+
+func TestFindUnreferencedRuleTags(t *testing.T) {
+	rules := map[string][]schemaregistryv1.Rule{
+		paramDomainRules: {
+			newTestRule("encryptPII", ruleKindTransform, ruleModeWriteRead, ruleTypeEncrypt, "", "PII", "SENSITIVE"),
+			newTestRule("maskPHI", ruleKindTransform, ruleModeWrite, ruleTypeCelField, "'XXX'", "PHI", "PRIVATE"),
+		},
+	}
+	schemaContent := `{"type": "record", "name": "User", "fields": [{"name": "ssn", "type": "string", "confluent:tags": ["PII"]}]}`
+	expected := []string{"SENSITIVE", "PRIVATE"}
+	if actual := findUnreferencedRuleTags(rules, schemaContent, []string{"PHI"}); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("findUnreferencedRuleTags() = %#v, expected %#v", actual, expected)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -765,6 +766,64 @@ func (c *KafkaRestClient) apiContext(ctx context.Context) context.Context {
 	return ctx
 }
 
+// executeRawRequest sends a request to a Kafka REST API v3 path (e.g., "/kafka/v3/clusters/lkc-abc123/topics/orders/records")
+// whose request or response fields are not (yet) covered by kafkarestv3 SDK.
+// It reuses HTTP client, user agent, default headers and credentials of the SDK client. On a non-2xx status code,
+// the returned *http.Response still has a readable body, so it can be passed to createDescriptiveError.
+func (c *KafkaRestClient) executeRawRequest(ctx context.Context, method, path string, requestBody, responseBody interface{}) (*http.Response, error) {
+	var body io.Reader
+	if requestBody != nil {
+		requestBodyJson, err := json.Marshal(requestBody)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling %#v to json: %s", requestBody, err)
+		}
+		body = bytes.NewReader(requestBodyJson)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(c.restEndpoint, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	config := c.apiClient.GetConfig()
+	for header, value := range config.DefaultHeader {
+		req.Header.Set(header, value)
+	}
+	req.Header.Set("User-Agent", config.UserAgent)
+	req.Header.Set("Accept", "application/json")
+	if requestBody != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	authCtx := c.apiContext(ctx)
+	if auth, ok := authCtx.Value(kafkarestv3.ContextBasicAuth).(kafkarestv3.BasicAuth); ok {
+		req.SetBasicAuth(auth.UserName, auth.Password)
+	}
+	if accessToken, ok := authCtx.Value(kafkarestv3.ContextAccessToken).(string); ok {
+		req.Header.Set("Authorization", "Bearer "+accessToken)
+	}
+
+	resp, err := config.HTTPClient.Do(req)
+	if err != nil {
+		return resp, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, err
+	}
+	// Make the body readable again for createDescriptiveError
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		return resp, errors.New(resp.Status)
+	}
+	if responseBody != nil && len(respBody) > 0 {
+		if err := json.Unmarshal(respBody, responseBody); err != nil {
+			return resp, fmt.Errorf("error unmarshaling %q: %s", string(respBody), err)
+		}
+	}
+	return resp, nil
+}
+
 func (c *SchemaRegistryRestClient) apiContext(ctx context.Context) context.Context {
 	if c.externalAccessToken != nil {
 		currToken := c.externalAccessToken
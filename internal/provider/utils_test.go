@@ -3314,6 +3314,24 @@ func TestCkuCheck(t *testing.T) {
 			availability: multiZone,
 			expectErr:    true,
 		},
+		{
+			name:         "LOW availability with 0 CKUs is invalid",
+			cku:          0,
+			availability: lowAvailability,
+			expectErr:    true,
+		},
+		{
+			name:         "HIGH availability with 1 CKU is invalid",
+			cku:          1,
+			availability: highAvailability,
+			expectErr:    true,
+		},
+		{
+			name:         "HIGH availability with 2 CKUs is valid",
+			cku:          2,
+			availability: highAvailability,
+			expectErr:    false,
+		},
 		{
 			name:         "unknown availability with CKU 0 passes (no check for unknown)",
 			cku:          0,
@@ -3331,6 +3349,75 @@ func TestCkuCheck(t *testing.T) {
 	}
 }
 
+func TestCkuZonesCheck(t *testing.T) {
+	if err := ckuZonesCheck(1, 1); err != nil {
+		t.Errorf("expected no error for 1 CKU in 1 zone, got %v", err)
+	}
+	if err := ckuZonesCheck(1, 0); err != nil {
+		t.Errorf("expected no error for 1 CKU in unknown zones, got %v", err)
+	}
+	if err := ckuZonesCheck(1, 3); err == nil {
+		t.Error("expected an error for 1 CKU in 3 zones")
+	}
+	if err := ckuZonesCheck(2, 3); err != nil {
+		t.Errorf("expected no error for 2 CKUs in 3 zones, got %v", err)
+	}
+}
+
+func TestPlanCkuUpdateSteps(t *testing.T) {
+	tests := []struct {
+		name       string
+		currentCku int32
+		desiredCku int32
+		maxCkuStep int32
+		expected   []int32
+	}{
+		{
+			name:       "no max step",
+			currentCku: 2,
+			desiredCku: 10,
+			maxCkuStep: 0,
+			expected:   []int32{10},
+		},
+		{
+			name:       "expansion within max step",
+			currentCku: 2,
+			desiredCku: 4,
+			maxCkuStep: 4,
+			expected:   []int32{4},
+		},
+		{
+			name:       "expansion in steps",
+			currentCku: 2,
+			desiredCku: 10,
+			maxCkuStep: 3,
+			expected:   []int32{5, 8, 10},
+		},
+		{
+			name:       "shrink in steps",
+			currentCku: 10,
+			desiredCku: 2,
+			maxCkuStep: 4,
+			expected:   []int32{6, 2},
+		},
+		{
+			name:       "unknown current CKU",
+			currentCku: 0,
+			desiredCku: 10,
+			maxCkuStep: 2,
+			expected:   []int32{10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			steps := planCkuUpdateSteps(tt.currentCku, tt.desiredCku, tt.maxCkuStep)
+			if !reflect.DeepEqual(steps, tt.expected) {
+				t.Errorf("planCkuUpdateSteps(%d, %d, %d) = %v, expected %v", tt.currentCku, tt.desiredCku, tt.maxCkuStep, steps, tt.expected)
+			}
+		})
+	}
+}
+
 func TestCreateSchemaId(t *testing.T) {
 	tests := []struct {
 		name                   string
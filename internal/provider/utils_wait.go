@@ -434,7 +434,26 @@ func waitForKafkaMirrorTopicToChangeStatus(ctx context.Context, c *KafkaRestClie
 	}
 
 	kafkaMirrorTopicId := createKafkaMirrorTopicId(clusterId, linkName, mirrorTopicName)
-	tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka Mirror Topic %q to be deleted", kafkaMirrorTopicId), map[string]interface{}{kafkaMirrorTopicLoggingKey: kafkaMirrorTopicId})
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka Mirror Topic %q status to become %q", kafkaMirrorTopicId, targetStatus), map[string]interface{}{kafkaMirrorTopicLoggingKey: kafkaMirrorTopicId})
+	if _, err := stateConf.WaitForStateContext(c.apiContext(ctx)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func waitForClusterLinkFailoverActionToComplete(ctx context.Context, c *KafkaRestClient, clusterId, linkName, mirrorTopicName, currentStatus string, actionSpec clusterLinkFailoverActionSpec, isAcceptanceTestMode bool) error {
+	delay, pollInterval := getDelayAndPollInterval(2*time.Second, 1*time.Minute, isAcceptanceTestMode)
+	stateConf := &resource.StateChangeConf{
+		Pending:      append([]string{currentStatus}, actionSpec.PendingStatuses...),
+		Target:       []string{actionSpec.TargetStatus},
+		Refresh:      kafkaMirrorTopicUpdateStatus(c.apiContext(ctx), c, clusterId, linkName, mirrorTopicName),
+		Timeout:      5 * time.Minute,
+		Delay:        delay,
+		PollInterval: pollInterval,
+	}
+
+	kafkaMirrorTopicId := createKafkaMirrorTopicId(clusterId, linkName, mirrorTopicName)
+	tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka Mirror Topic %q status to become %q", kafkaMirrorTopicId, actionSpec.TargetStatus), map[string]interface{}{kafkaMirrorTopicLoggingKey: kafkaMirrorTopicId})
 	if _, err := stateConf.WaitForStateContext(c.apiContext(ctx)); err != nil {
 		return err
 	}
@@ -559,14 +578,13 @@ func waitForTransitGatewayAttachmentToProvision(ctx context.Context, c *Client,
 	return nil
 }
 
-func waitForKafkaClusterCkuUpdateToComplete(ctx context.Context, c *Client, environmentId, clusterId string, cku int32) error {
+func waitForKafkaClusterCkuUpdateToComplete(ctx context.Context, c *Client, environmentId, clusterId string, cku int32, timeout time.Duration) error {
 	delay, pollInterval := getDelayAndPollInterval(5*time.Second, 1*time.Minute, c.isAcceptanceTestMode)
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{stateInProgress},
-		Target:  []string{stateDone},
-		Refresh: kafkaClusterCkuUpdateStatus(c.cmkV2ApiContext(ctx), c, environmentId, clusterId, cku),
-		// https://docs.confluent.io/cloud/current/clusters/cluster-types.html#resizing-time
-		Timeout:      getTimeoutFor(kafkaClusterTypeDedicated),
+		Pending:      []string{stateInProgress},
+		Target:       []string{stateDone},
+		Refresh:      kafkaClusterCkuUpdateStatus(c.cmkV2ApiContext(ctx), c, environmentId, clusterId, cku, time.Now()),
+		Timeout:      timeout,
 		Delay:        delay,
 		PollInterval: pollInterval,
 	}
@@ -896,7 +914,7 @@ func clusterLinkDeleteStatus(ctx context.Context, c *KafkaRestClient, linkName s
 	}
 }
 
-func kafkaClusterCkuUpdateStatus(ctx context.Context, c *Client, environmentId string, clusterId string, desiredCku int32) resource.StateRefreshFunc {
+func kafkaClusterCkuUpdateStatus(ctx context.Context, c *Client, environmentId string, clusterId string, desiredCku int32, startTime time.Time) resource.StateRefreshFunc {
 	return func() (result interface{}, s string, err error) {
 		cluster, resp, err := executeKafkaRead(c.cmkV2ApiContext(ctx), c, environmentId, clusterId)
 		if err != nil {
@@ -904,7 +922,9 @@ func kafkaClusterCkuUpdateStatus(ctx context.Context, c *Client, environmentId s
 			return nil, stateUnknown, err
 		}
 
-		tflog.Debug(ctx, fmt.Sprintf("Waiting for Kafka Cluster %q CKU update", clusterId), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
+		// CKU updates can take hours, so report their progress at every poll
+		tflog.Info(ctx, fmt.Sprintf("Waiting for Kafka Cluster %q CKU update to %d CKUs: current phase is %q, %d CKUs are in effect, elapsed time is %s",
+			clusterId, desiredCku, cluster.Status.GetPhase(), cluster.Status.GetCku(), time.Since(startTime).Round(time.Second)), map[string]interface{}{kafkaClusterLoggingKey: clusterId})
 		// Wail until actual # of CKUs is the same as desired one
 		// spec.cku is the user’s desired # of CKUs, and status.cku is the current # of CKUs in effect
 		// because the change is still pending, for example